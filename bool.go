@@ -1,7 +1,6 @@
 package validator
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 )
@@ -21,6 +20,10 @@ func (v BoolIsTrueValidator) Validate(value bool) error {
 	return nil
 }
 
+func (v BoolIsTrueValidator) Describe() Description {
+	return Description{Kind: "bool.true", Summary: "must be true"}
+}
+
 type BoolIsFalseValidator struct{}
 
 func (v BoolIsFalseValidator) Validate(value bool) error {
@@ -30,43 +33,137 @@ func (v BoolIsFalseValidator) Validate(value bool) error {
 	return nil
 }
 
-func ValidateMapBool(name string, value map[string]any, rules BoolValidators) (bool, error) {
-	rawValue, ok := value[name]
+func (v BoolIsFalseValidator) Describe() Description {
+	return Description{Kind: "bool.false", Summary: "must be false"}
+}
+
+// BoolProfile decides which inputs ValidateBool reads as true or false.
+type BoolProfile struct {
+	// Truthy and Falsy are the strings accepted for true and false, ignoring case.
+	Truthy []string
+	Falsy  []string
+	// Numbers accepts the numbers 1 and 0.
+	Numbers bool
+	// MissingIsFalse makes ValidateMapBool read a missing key as false.
+	MissingIsFalse bool
+}
+
+var (
+	BoolDefaultProfile = BoolProfile{
+		Truthy:  []string{"true", "on"},
+		Falsy:   []string{"false"},
+		Numbers: true,
+	}
+	BoolStrictProfile   = BoolProfile{}
+	BoolCheckboxProfile = BoolProfile{
+		Truthy:         []string{"on", "true", "1", "yes"},
+		Falsy:          []string{"", "off", "false", "0", "no"},
+		MissingIsFalse: true,
+	}
+	BoolPermissiveProfile = BoolProfile{
+		Truthy:  []string{"true", "yes", "y", "1", "on"},
+		Falsy:   []string{"false", "no", "n", "0", "off"},
+		Numbers: true,
+	}
+)
+
+// WithBoolProfile sets how ValidateBool reads its input, BoolDefaultProfile by default.
+func WithBoolProfile(profile BoolProfile) Option {
+	return func(o *options) {
+		o.boolProfile = profile
+	}
+}
+
+func ValidateMapBool(
+	name string,
+	value map[string]any,
+	rules BoolValidators,
+	opts ...Option,
+) (bool, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return false, err
+	}
 	if !ok {
-		return false, fmt.Errorf("missing key \"%v\"", name)
+		if newOptions(opts).boolProfile.MissingIsFalse {
+			return ValidateBool(false, rules, opts...)
+		}
+		return false, missingKeyError(name)
 	}
-	return ValidateBool(rawValue, rules)
+	return ValidateBool(rawValue, rules, opts...)
+}
+
+func ValidateMapBoolOrNil(
+	name string,
+	value map[string]any,
+	rules BoolValidators,
+	opts ...Option,
+) (*bool, error) {
+	return validateMapOrNil(name, value, ValidateBool, rules, opts)
+}
+
+func ValidateMapBoolOrDefault(
+	name string,
+	value map[string]any,
+	rules BoolValidators,
+	defaultValue bool,
+	opts ...Option,
+) (bool, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateBool, rules, func() bool {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapBoolOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules BoolValidators,
+	defaultValue func() bool,
+	opts ...Option,
+) (bool, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateBool, rules, defaultValue, opts)
 }
 
 func ValidateMapBoolOrFalse(
 	name string,
 	value map[string]any,
 	rules BoolValidators,
+	opts ...Option,
 ) (bool, error) {
-	rawValue, ok := value[name]
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return false, err
+	}
 	if !ok {
 		return false, nil
 	}
 
-	val, err := ValidateBool(rawValue, rules)
+	val, err := ValidateBool(rawValue, rules, opts...)
 
 	return val, err
 }
 
-func ValidateBool(value any, rules BoolValidators) (bool, error) {
+func ValidateBool(value any, rules BoolValidators, opts ...Option) (bool, error) {
+	o := newOptions(opts)
+	profile := o.boolProfile
+
 	boolValue, boolOk := value.(bool)
 	intValue, intOk := value.(int)
 	floatValue, floatOk := value.(float64)
 	stringValue, stringOk := value.(string)
 	if !boolOk && !intOk && !floatOk && !stringOk {
-		return false, errors.New("value is not a bool")
+		return false, typeError("value is not a bool")
+	}
+
+	if (intOk || floatOk) && !profile.Numbers {
+		return false, typeError("value is not a bool")
 	}
 
 	if floatOk {
 		if floatValue == float64(int(floatValue)) {
 			intValue = (int(floatValue))
 		} else {
-			return false, errors.New("value is not a bool")
+			return false, typeError("value is not a bool")
 		}
 	}
 
@@ -76,29 +173,32 @@ func ValidateBool(value any, rules BoolValidators) (bool, error) {
 		} else if intValue == 0 {
 			boolValue = false
 		} else {
-			return false, errors.New("value is not a bool")
+			return false, typeError("value is not a bool")
 		}
 	}
 
 	if stringOk {
-		normalizedStr := strings.ToLower(stringValue)
-
-		if normalizedStr == "on" {
+		if containsFold(profile.Truthy, stringValue) {
 			boolValue = true
-		} else if normalizedStr == "true" {
-			boolValue = true
-		} else if normalizedStr == "false" {
+		} else if containsFold(profile.Falsy, stringValue) {
 			boolValue = false
 		} else {
-			return false, errors.New("value is not a bool")
+			return false, typeError("value is not a bool")
 		}
 	}
 
-	for _, rule := range rules {
-		if err := rule.Validate(boolValue); err != nil {
-			return false, err
-		}
+	if err := applyRules(o.context(), rules, boolValue); err != nil {
+		return false, err
 	}
 
 	return boolValue, nil
 }
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -114,5 +114,135 @@ func boolValidatorTests() {
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).To(Equal("this bool validator always fail"))
 		})
+		DescribeTable("should only accept JSON bools with the strict profile",
+			func(value any, valid bool) {
+				// act
+				_, err := validator.ValidateBool(
+					value,
+					validator.BoolValidators{},
+					validator.WithBoolProfile(validator.BoolStrictProfile),
+				)
+
+				// assert
+				if valid {
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(Equal("value is not a bool"))
+				}
+			},
+			Entry("true", true, true),
+			Entry("false", false, true),
+			Entry("the string true", "true", false),
+			Entry("the number 1", 1, false),
+			Entry("the float 1", 1.0, false),
+		)
+
+		DescribeTable("should read the permissive profile inputs",
+			func(value any, expected bool) {
+				// act
+				result, err := validator.ValidateBool(
+					value,
+					validator.BoolValidators{},
+					validator.WithBoolProfile(validator.BoolPermissiveProfile),
+				)
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(expected))
+			},
+			Entry("yes", "yes", true),
+			Entry("No", "No", false),
+			Entry("y", "y", true),
+			Entry("n", "n", false),
+			Entry("1", "1", true),
+			Entry("0", "0", false),
+			Entry("on", "on", true),
+			Entry("off", "off", false),
+		)
+
+		It("should read a custom profile", func() {
+			// arrange
+			value := "oui"
+
+			// act
+			result, err := validator.ValidateBool(
+				value,
+				validator.BoolValidators{},
+				validator.WithBoolProfile(validator.BoolProfile{
+					Truthy: []string{"oui"},
+					Falsy:  []string{"non"},
+				}),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(true))
+		})
+	})
+
+	Describe("ValidateMapBool", func() {
+		It("should read a missing checkbox as false", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			result, err := validator.ValidateMapBool(
+				"newsletter",
+				value,
+				validator.BoolValidators{},
+				validator.WithBoolProfile(validator.BoolCheckboxProfile),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(false))
+		})
+
+		It("should read an empty checkbox as false", func() {
+			// arrange
+			value := map[string]any{"newsletter": ""}
+
+			// act
+			result, err := validator.ValidateMapBool(
+				"newsletter",
+				value,
+				validator.BoolValidators{},
+				validator.WithBoolProfile(validator.BoolCheckboxProfile),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(false))
+		})
+
+		It("should still validate a missing checkbox through the rules", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			_, err := validator.ValidateMapBool(
+				"terms",
+				value,
+				validator.BoolValidators{validator.BoolIsTrueValidator{}},
+				validator.WithBoolProfile(validator.BoolCheckboxProfile),
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must be true"))
+		})
+
+		It("should not read a missing key as false with the default profile", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			_, err := validator.ValidateMapBool("terms", value, validator.BoolValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing key \"terms\""))
+		})
 	})
 }
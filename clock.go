@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"context"
+	"time"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (c SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+type FixedClock struct {
+	Time time.Time
+}
+
+func (c FixedClock) Now() time.Time {
+	return c.Time
+}
+
+type clockContextKey struct{}
+
+func ContextWithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, clock)
+}
+
+// ClockFromContext returns the Clock stored in ctx, or a SystemClock when there is none.
+func ClockFromContext(ctx context.Context) Clock {
+	if clock, ok := ctx.Value(clockContextKey{}).(Clock); ok && clock != nil {
+		return clock
+	}
+	return SystemClock{}
+}
+
+// WithClock sets the Clock read by relative time rules that have no Clock of their own.
+func WithClock(clock Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+func now(ctx context.Context, clock Clock) time.Time {
+	if clock == nil {
+		clock = ClockFromContext(ctx)
+	}
+	return clock.Now()
+}
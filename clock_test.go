@@ -0,0 +1,35 @@
+package validator_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func clockTests() {
+	Describe("ClockFromContext", func() {
+		It("should return the Clock stored in the context", func() {
+			// arrange
+			clock := validator.FixedClock{Time: time.Date(2023, 12, 24, 5, 0, 0, 0, time.UTC)}
+			ctx := validator.ContextWithClock(context.Background(), clock)
+
+			// act
+			result := validator.ClockFromContext(ctx)
+
+			// assert
+			Expect(result).To(Equal(clock))
+		})
+
+		It("should return a SystemClock when the context has no Clock", func() {
+			// act
+			result := validator.ClockFromContext(context.Background())
+
+			// assert
+			Expect(result).To(Equal(validator.SystemClock{}))
+		})
+	})
+}
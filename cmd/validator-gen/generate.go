@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gungun974/validator"
+)
+
+const validatorPath = "github.com/gungun974/validator"
+
+type fieldType struct {
+	rules string
+	name  string
+	// with is the name used by the validator functions taking rules, name
+	// when empty.
+	with string
+}
+
+// fieldTypes maps the supported field types, as written in the source, to
+// their rule string type and the name used by the validator functions.
+var fieldTypes = map[string]fieldType{
+	"string":         {rules: "string", name: "String"},
+	"int":            {rules: "int", name: "Int"},
+	"float64":        {rules: "float", name: "Float"},
+	"bool":           {rules: "bool", name: "Bool"},
+	"time.Time":      {rules: "time", name: "Time"},
+	"time.Duration":  {rules: "duration", name: "Duration"},
+	"validator.Date": {rules: "date", name: "Date"},
+	"uuid.UUID":      {rules: "uuid", name: "UUID", with: "UUIDWith"},
+}
+
+type structSpec struct {
+	Name   string
+	Fields []fieldSpec
+}
+
+type fieldSpec struct {
+	Name     string
+	Key      string
+	Type     string
+	Func     string
+	Pointer  bool
+	Required bool
+	Rules    []string
+}
+
+type fileSpec struct {
+	Package string
+	Imports []string
+	Structs []structSpec
+}
+
+func generate(dir string, typeNames []string) ([]byte, error) {
+	files, err := parseDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %v", dir)
+	}
+
+	spec := fileSpec{Package: files[0].Name.Name}
+	imports := map[string]bool{validatorPath: true}
+
+	for _, typeName := range typeNames {
+		structType := findStruct(files, typeName)
+		if structType == nil {
+			return nil, fmt.Errorf("struct type %v not found", typeName)
+		}
+
+		s, err := readStruct(typeName, structType, imports)
+		if err != nil {
+			return nil, err
+		}
+		spec.Structs = append(spec.Structs, s)
+	}
+
+	for path := range imports {
+		spec.Imports = append(spec.Imports, path)
+	}
+	sort.Strings(spec.Imports)
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, spec); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func parseDir(dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func findStruct(files []*ast.File, name string) *ast.StructType {
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if typeSpec.Name.Name != name {
+					continue
+				}
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					return structType
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func readStruct(name string, structType *ast.StructType, imports map[string]bool) (structSpec, error) {
+	s := structSpec{Name: name}
+
+	for _, field := range structType.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		rawTag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			return structSpec{}, err
+		}
+		tag := reflect.StructTag(rawTag)
+		rules, ok := tag.Lookup("validate")
+		if !ok || rules == "-" {
+			continue
+		}
+
+		label := name + "." + fieldLabel(field)
+
+		typeExpr := field.Type
+		pointer := false
+		if star, ok := typeExpr.(*ast.StarExpr); ok {
+			typeExpr = star.X
+			pointer = true
+		}
+		fieldType, ok := fieldTypes[types.ExprString(typeExpr)]
+		if !ok {
+			return structSpec{}, fmt.Errorf("%v: unsupported field type %v", label, types.ExprString(field.Type))
+		}
+
+		set, err := validator.ParseRules(fieldType.rules + "|" + rules)
+		if err != nil {
+			return structSpec{}, fmt.Errorf("%v: %w", label, err)
+		}
+		literals, err := ruleLiterals(set, imports)
+		if err != nil {
+			return structSpec{}, fmt.Errorf("%v: %w", label, err)
+		}
+
+		for _, fieldName := range field.Names {
+			if !fieldName.IsExported() {
+				continue
+			}
+			key := fieldName.Name
+			mapFunc := fieldType.name
+			if fieldType.with != "" {
+				mapFunc = fieldType.with
+			}
+			if jsonName, _, _ := strings.Cut(tag.Get("json"), ","); jsonName != "" && jsonName != "-" {
+				key = jsonName
+			}
+			s.Fields = append(s.Fields, fieldSpec{
+				Name:     fieldName.Name,
+				Key:      key,
+				Type:     fieldType.name,
+				Func:     mapFunc,
+				Pointer:  pointer,
+				Required: set.Required,
+				Rules:    literals,
+			})
+		}
+	}
+
+	return s, nil
+}
+
+// fieldLabel names a field in errors, by its type when it is embedded.
+func fieldLabel(field *ast.Field) string {
+	if len(field.Names) == 0 {
+		return types.ExprString(field.Type)
+	}
+	names := make([]string, 0, len(field.Names))
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// ruleLiterals returns the Go source of the rules of set, which must all be
+// built-in rules.
+func ruleLiterals(set validator.RuleSet, imports map[string]bool) ([]string, error) {
+	var rules []any
+	switch set.Type {
+	case "string":
+		rules = appendRules(rules, set.String)
+	case "int":
+		rules = appendRules(rules, set.Int)
+	case "float":
+		rules = appendRules(rules, set.Float)
+	case "bool":
+		rules = appendRules(rules, set.Bool)
+	case "time":
+		rules = appendRules(rules, set.Time)
+	case "duration":
+		rules = appendRules(rules, set.Duration)
+	case "date":
+		rules = appendRules(rules, set.Date)
+	case "uuid":
+		rules = appendRules(rules, set.UUID)
+	}
+
+	literals := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleType := reflect.TypeOf(rule)
+		if ruleType.PkgPath() != validatorPath || ruleType.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("rule %T cannot be generated", rule)
+		}
+		literal := fmt.Sprintf("%#v", utcTimes(rule))
+		if strings.Contains(literal, "time.") {
+			imports["time"] = true
+		}
+		if strings.Contains(literal, "uuid.") {
+			imports["github.com/google/uuid"] = true
+		}
+		literals = append(literals, literal)
+	}
+	return literals, nil
+}
+
+// utcTimes returns a copy of rule with its times in UTC, as %#v prints a
+// time in any other location with a time.Location literal that does not
+// compile. The rules compare instants, which the conversion keeps.
+func utcTimes(rule any) any {
+	value := reflect.New(reflect.TypeOf(rule)).Elem()
+	value.Set(reflect.ValueOf(rule))
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		if t, ok := field.Interface().(time.Time); ok {
+			field.Set(reflect.ValueOf(t.UTC()))
+		}
+	}
+	return value.Interface()
+}
+
+func appendRules[R any](rules []any, slice []R) []any {
+	for _, rule := range slice {
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by validator-gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Structs}}
+func Validate{{.Name}}(value map[string]any, opts ...validator.Option) ({{.Name}}, error) {
+	var result {{.Name}}
+	var errs validator.Errors
+{{range .Fields}}
+	if v, err := validator.ValidateMap{{.Func}}{{if not .Required}}OrNil{{end}}({{printf "%q" .Key}}, value, validator.{{.Type}}Validators{
+	{{- range .Rules}}
+		{{.}},
+	{{- end}}
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors({{printf "%q" .Key}}, err)...)
+	}
+	{{- if .Required}} else {
+		result.{{.Name}} = {{if .Pointer}}&{{end}}v
+	}
+	{{- else if .Pointer}} else {
+		result.{{.Name}} = v
+	}
+	{{- else}} else if v != nil {
+		result.{{.Name}} = *v
+	}
+	{{- end}}
+{{end}}
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
+}
+{{end}}`))
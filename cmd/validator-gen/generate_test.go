@@ -0,0 +1,192 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gungun974/validator"
+	"github.com/gungun974/validator/cmd/validator-gen/internal/example"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidatorGen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ValidatorGen")
+}
+
+func writeSource(src string) string {
+	dir := GinkgoT().TempDir()
+	Expect(os.WriteFile(filepath.Join(dir, "model.go"), []byte(src), 0o644)).To(Succeed())
+	return dir
+}
+
+// writeModuleSource writes src in a directory of this module, so that the
+// generated code can import the validator package when type checked.
+func writeModuleSource(src string) string {
+	dir, err := os.MkdirTemp(".", "model")
+	Expect(err).ShouldNot(HaveOccurred())
+	DeferCleanup(os.RemoveAll, dir)
+	Expect(os.WriteFile(filepath.Join(dir, "model.go"), []byte(src), 0o644)).To(Succeed())
+	return dir
+}
+
+func typeCheck(dir string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, 0)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range pkgs {
+		files := make([]*ast.File, 0, len(pkg.Files))
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+		config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+		if _, err := config.Check(pkg.Name, fset, files, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ = Describe("generate", func() {
+	It("should match the generated example", func() {
+		// arrange
+		expected, err := os.ReadFile("internal/example/user_validator.go")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// act
+		src, err := generate("internal/example", []string{"User", "Event"})
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(src)).To(Equal(string(expected)))
+	})
+
+	It("should refuse an unsupported field type", func() {
+		// arrange
+		dir := writeSource("package model\n\ntype User struct {\n\tTags []string `validate:\"required\"`\n}\n")
+
+		// act
+		_, err := generate(dir, []string{"User"})
+
+		// assert
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(Equal("User.Tags: unsupported field type []string"))
+	})
+
+	It("should print an unsupported pointer type as written", func() {
+		// arrange
+		dir := writeSource("package model\n\ntype User struct {\n\tScores *map[string]int `validate:\"required\"`\n}\n")
+
+		// act
+		_, err := generate(dir, []string{"User"})
+
+		// assert
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(Equal("User.Scores: unsupported field type *map[string]int"))
+	})
+
+	It("should generate a time bound with an offset that compiles", func() {
+		// arrange
+		dir := writeModuleSource("package model\n\nimport \"time\"\n\n" +
+			"type Event struct {\n\tStart time.Time `validate:\"required|min:2024-01-01T00:00:00+02:00\"`\n}\n")
+
+		// act
+		src, err := generate(dir, []string{"Event"})
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring("time.Date(2023, time.December, 31, 22, 0, 0, 0, time.UTC)"))
+		Expect(os.WriteFile(filepath.Join(dir, "event_validator.go"), src, 0o644)).To(Succeed())
+		Expect(typeCheck(dir)).To(Succeed())
+	})
+
+	It("should generate an optional UUID field that compiles", func() {
+		// arrange
+		dir := writeModuleSource("package model\n\nimport \"github.com/google/uuid\"\n\n" +
+			"type Order struct {\n\tParent *uuid.UUID `validate:\"not_nil\"`\n}\n")
+
+		// act
+		src, err := generate(dir, []string{"Order"})
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring("validator.ValidateMapUUIDWithOrNil(\"Parent\""))
+		Expect(os.WriteFile(filepath.Join(dir, "order_validator.go"), src, 0o644)).To(Succeed())
+		Expect(typeCheck(dir)).To(Succeed())
+	})
+
+	It("should report a bad rule string", func() {
+		// arrange
+		dir := writeSource("package model\n\ntype User struct {\n\tEmail string `validate:\"required|emial\"`\n}\n")
+
+		// act
+		_, err := generate(dir, []string{"User"})
+
+		// assert
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("User.Email: "))
+		Expect(err.Error()).To(ContainSubstring("unknown string rule \"emial\""))
+	})
+
+	It("should report a missing type", func() {
+		// arrange
+		dir := writeSource("package model\n\ntype User struct{}\n")
+
+		// act
+		_, err := generate(dir, []string{"Account"})
+
+		// assert
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(Equal("struct type Account not found"))
+	})
+})
+
+var _ = Describe("generated ValidateUser", func() {
+	It("should fill the struct", func() {
+		// arrange
+		value := map[string]any{
+			"id":    "c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2",
+			"email": "john@doe.com",
+			"age":   42,
+			"terms": true,
+		}
+
+		// act
+		user, err := example.ValidateUser(value)
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(user.Email).To(Equal("john@doe.com"))
+		Expect(*user.Age).To(Equal(42))
+		Expect(user.Name).To(Equal(""))
+	})
+
+	It("should report every invalid field", func() {
+		// arrange
+		value := map[string]any{
+			"id":    "c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2",
+			"email": "garbage",
+			"age":   12,
+		}
+
+		// act
+		_, err := example.ValidateUser(value)
+
+		// assert
+		var errs validator.Errors
+		Expect(err).To(BeAssignableToTypeOf(errs))
+		Expect(err.Error()).To(Equal(
+			"email: value is not an email, age: value must not be greater than 18, terms: missing key \"terms\"",
+		))
+	})
+})
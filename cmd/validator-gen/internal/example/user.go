@@ -0,0 +1,28 @@
+// Package example holds the struct types validator-gen is tested against.
+package example
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gungun974/validator"
+)
+
+//go:generate go run github.com/gungun974/validator/cmd/validator-gen -type User,Event
+
+type User struct {
+	ID       uuid.UUID `json:"id" validate:"required|version:4"`
+	Email    string    `json:"email" validate:"required|email|max:255"`
+	Name     string    `json:"name" validate:"min:2"`
+	Age      *int      `json:"age" validate:"min:18"`
+	Score    float64   `json:"score" validate:"max:100"`
+	Terms    bool      `json:"terms" validate:"required|accepted"`
+	Internal string    `json:"-"`
+}
+
+type Event struct {
+	Day      validator.Date `json:"day" validate:"required|min:2024-01-01"`
+	StartsAt time.Time      `json:"starts_at" validate:"required"`
+	Length   time.Duration  `validate:"max:2h"`
+}
@@ -0,0 +1,126 @@
+// Code generated by validator-gen. DO NOT EDIT.
+
+package example
+
+import (
+	"github.com/google/uuid"
+	"github.com/gungun974/validator"
+)
+
+func ValidateUser(value map[string]any, opts ...validator.Option) (User, error) {
+	var result User
+	var errs validator.Errors
+
+	if v, err := validator.ValidateMapUUIDWith("id", value, validator.UUIDValidators{
+		validator.UUIDVersionValidator{Versions: []uuid.Version{0x4}},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("id", err)...)
+	} else {
+		result.ID = v
+	}
+
+	if v, err := validator.ValidateMapString("email", value, validator.StringValidators{
+		validator.StringEmailValidator{},
+		validator.StringMaxValidator{Max: 255},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("email", err)...)
+	} else {
+		result.Email = v
+	}
+
+	if v, err := validator.ValidateMapStringOrNil("name", value, validator.StringValidators{
+		validator.StringMinValidator{Min: 2},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("name", err)...)
+	} else if v != nil {
+		result.Name = *v
+	}
+
+	if v, err := validator.ValidateMapIntOrNil("age", value, validator.IntValidators{
+		validator.IntMinValidator{Min: 18},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("age", err)...)
+	} else {
+		result.Age = v
+	}
+
+	if v, err := validator.ValidateMapFloatOrNil("score", value, validator.FloatValidators{
+		validator.FloatMaxValidator{Max: 100},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("score", err)...)
+	} else if v != nil {
+		result.Score = *v
+	}
+
+	if v, err := validator.ValidateMapBool("terms", value, validator.BoolValidators{
+		validator.BoolIsTrueValidator{},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("terms", err)...)
+	} else {
+		result.Terms = v
+	}
+
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
+}
+
+func ValidateEvent(value map[string]any, opts ...validator.Option) (Event, error) {
+	var result Event
+	var errs validator.Errors
+
+	if v, err := validator.ValidateMapDate("day", value, validator.DateValidators{
+		validator.DateMinValidator{Min: validator.Date{Year: 2024, Month: 1, Day: 1}},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("day", err)...)
+	} else {
+		result.Day = v
+	}
+
+	if v, err := validator.ValidateMapTime("starts_at", value, validator.TimeValidators{}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("starts_at", err)...)
+	} else {
+		result.StartsAt = v
+	}
+
+	if v, err := validator.ValidateMapDurationOrNil("Length", value, validator.DurationValidators{
+		validator.DurationMaxValidator{Max: 7200000000000},
+	}, opts...); err != nil {
+		if validator.IsLookupError(err) {
+			return result, err
+		}
+		errs = append(errs, validator.PrefixErrors("Length", err)...)
+	} else if v != nil {
+		result.Length = *v
+	}
+
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
+}
@@ -0,0 +1,61 @@
+// Command validator-gen generates, for annotated struct types, functions
+// validating a map[string]any into the struct without reflection.
+//
+// It is meant to run from go generate:
+//
+//	//go:generate go run github.com/gungun974/validator/cmd/validator-gen -type User
+//
+// Each field to validate carries a validate tag holding a rule string as read
+// by validator.ParseRules, without its type which comes from the field type.
+// The key is read from the json tag, or is the field name:
+//
+//	type User struct {
+//		Email string `json:"email" validate:"required|email|max:255"`
+//		Age   *int   `json:"age" validate:"min:18"`
+//	}
+//
+// For each type T, the generated ValidateT(value map[string]any, opts
+// ...validator.Option) (T, error) reports every invalid field in a
+// validator.Errors. Optional fields are left untouched when missing.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma-separated list of struct type names")
+	output := flag.String("output", "", "output file name; default <dir>/<first type>_validator.go")
+	flag.Parse()
+
+	if *typeNames == "" {
+		fmt.Fprintln(os.Stderr, "validator-gen: -type is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	types := strings.Split(*typeNames, ",")
+	src, err := generate(dir, types)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "validator-gen: %v\n", err)
+		os.Exit(1)
+	}
+
+	path := *output
+	if path == "" {
+		path = filepath.Join(dir, strings.ToLower(types[0])+"_validator.go")
+	}
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "validator-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
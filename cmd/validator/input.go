@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// record is one value to validate, numbered from 1 in its file, or by line
+// for newline-delimited JSON.
+type record struct {
+	number int
+	value  any
+}
+
+func readRecords(file, format string, stdin io.Reader) ([]record, error) {
+	if format == "" {
+		format = formatOf(file)
+	}
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var records []record
+	switch format {
+	case "json":
+		records, err = readJSON(data)
+	case "yaml":
+		records, err = readYAML(data)
+	case "ndjson":
+		records, err = readNDJSON(data)
+	default:
+		return nil, fmt.Errorf("unknown input format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", file, err)
+	}
+	return records, nil
+}
+
+func formatOf(file string) string {
+	switch filepath.Ext(file) {
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	}
+	return "json"
+}
+
+// splitRecords reads a list as one record per item, and anything else as a
+// single record.
+func splitRecords(value any) []record {
+	list, ok := value.([]any)
+	if !ok {
+		return []record{{number: 1, value: value}}
+	}
+	records := make([]record, 0, len(list))
+	for i, item := range list {
+		records = append(records, record{number: i + 1, value: item})
+	}
+	return records
+}
+
+func readJSON(data []byte) ([]record, error) {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return splitRecords(value), nil
+}
+
+func readYAML(data []byte) ([]record, error) {
+	var records []record
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var value any
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range splitRecords(value) {
+			records = append(records, record{number: len(records) + 1, value: r.value})
+		}
+	}
+	return records, nil
+}
+
+func readNDJSON(data []byte) ([]record, error) {
+	var records []record
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(text, &value); err != nil {
+			return nil, fmt.Errorf("line %v: %w", line, err)
+		}
+		records = append(records, record{number: line, value: value})
+	}
+	return records, scanner.Err()
+}
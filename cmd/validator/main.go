@@ -0,0 +1,88 @@
+// Command validator checks JSON, YAML and newline-delimited JSON files
+// against a schema, printing the errors of every record and exiting with
+// status 1 when any record is invalid, or 2 on a usage or read error.
+//
+//	validator -schema user.schema.json [-format json|yaml|ndjson] [-output text|json] [file ...]
+//
+// The schema is either a JSON Schema, for the subset of keywords the library
+// can check, or the library's own format, written in JSON or YAML, mapping
+// each field to a rule string as read by validator.ParseRules or to a nested
+// schema:
+//
+//	fields:
+//	  email: required|email
+//	  age: int|min:18
+//	  address:
+//	    required: true
+//	    fields:
+//	      city: required|string
+//	unknown_keys: strict
+//
+// Without files, records are read from the standard input.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	exitInvalid = 1
+	exitError   = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validator", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	schemaPath := flags.String("schema", "", "schema file, in JSON Schema or the validator schema format")
+	format := flags.String("format", "", "input format: json, yaml or ndjson; guessed from the file extension by default")
+	output := flags.String("output", "text", "output format: text or json")
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if *schemaPath == "" {
+		fmt.Fprintln(stderr, "validator: -schema is required")
+		flags.Usage()
+		return exitError
+	}
+	if *output != "text" && *output != "json" {
+		fmt.Fprintf(stderr, "validator: unknown output format %q\n", *output)
+		return exitError
+	}
+
+	schema, err := loadSchema(*schemaPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "validator: %v\n", err)
+		return exitError
+	}
+
+	var problems []problem
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	for _, file := range files {
+		records, err := readRecords(file, *format, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "validator: %v\n", err)
+			return exitError
+		}
+		problems = append(problems, validateRecords(schema, file, records)...)
+	}
+
+	if err := writeProblems(stdout, *output, problems); err != nil {
+		fmt.Fprintf(stderr, "validator: %v\n", err)
+		return exitError
+	}
+	if len(problems) > 0 {
+		return exitInvalid
+	}
+	return 0
+}
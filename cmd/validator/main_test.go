@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidatorCLI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ValidatorCLI")
+}
+
+const ownSchemaSource = `
+fields:
+  email: required|email
+  age: int|min:18
+  address:
+    required: true
+    fields:
+      city: required|string|min:2
+unknown_keys: strict
+`
+
+const jsonSchemaSource = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "required": ["id", "email"],
+  "additionalProperties": false,
+  "properties": {
+    "id": {"type": "string", "format": "uuid"},
+    "email": {"type": "string", "format": "email", "maxLength": 50},
+    "age": {"type": "integer", "minimum": 18}
+  }
+}`
+
+var _ = Describe("run", func() {
+	var dir string
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+		return path
+	}
+
+	runCLI := func(args ...string) (int, string, string) {
+		var stdout, stderr bytes.Buffer
+		code := run(args, strings.NewReader(""), &stdout, &stderr)
+		return code, stdout.String(), stderr.String()
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("should accept valid records", func() {
+		// arrange
+		schema := write("schema.yaml", ownSchemaSource)
+		data := write("users.json", `[{"email": "john@doe.com", "address": {"city": "Paris"}}]`)
+
+		// act
+		code, stdout, _ := runCLI("-schema", schema, data)
+
+		// assert
+		Expect(code).To(Equal(0))
+		Expect(stdout).To(BeEmpty())
+	})
+
+	It("should print the errors of every record by path", func() {
+		// arrange
+		schema := write("schema.yaml", ownSchemaSource)
+		data := write("users.json", `[
+			{"email": "john@doe.com", "address": {"city": "Paris"}},
+			{"email": "garbage", "age": 12, "address": {"city": "P"}, "emial": "x"}
+		]`)
+
+		// act
+		code, stdout, _ := runCLI("-schema", schema, data)
+
+		// assert
+		Expect(code).To(Equal(1))
+		Expect(stdout).To(Equal(strings.Join([]string{
+			data + ": record 2: address.city: value length must not be greater than 2",
+			data + ": record 2: age: value must not be greater than 18",
+			data + ": record 2: email: value is not an email",
+			data + ": record 2: emial: unknown key, did you mean \"email\"?",
+			"",
+		}, "\n")))
+	})
+
+	It("should read newline-delimited JSON by line", func() {
+		// arrange
+		schema := write("schema.json", jsonSchemaSource)
+		data := write("users.ndjson", strings.Join([]string{
+			`{"id": "c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2", "email": "john@doe.com"}`,
+			``,
+			`{"id": "nope", "email": "jane@doe.com", "age": 30}`,
+		}, "\n"))
+
+		// act
+		code, stdout, _ := runCLI("-schema", schema, "-output", "json", data)
+
+		// assert
+		Expect(code).To(Equal(1))
+		var problems []map[string]any
+		Expect(json.Unmarshal([]byte(stdout), &problems)).To(Succeed())
+		Expect(problems).To(Equal([]map[string]any{{
+			"file":    data,
+			"record":  3.0,
+			"path":    "id",
+			"message": "value is an invalid UUID",
+		}}))
+	})
+
+	It("should read YAML documents", func() {
+		// arrange
+		schema := write("schema.json", jsonSchemaSource)
+		data := write("users.yaml", "id: c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2\nemail: john@doe.com\n---\nemail: jane@doe.com\n")
+
+		// act
+		code, stdout, _ := runCLI("-schema", schema, data)
+
+		// assert
+		Expect(code).To(Equal(1))
+		Expect(stdout).To(Equal(data + ": record 2: id: missing key \"id\"\n"))
+	})
+
+	It("should reject values of the wrong JSON type", func() {
+		// arrange
+		schema := write("schema.json", `{
+			"type": "object",
+			"properties": {
+				"name": {"type": "string"},
+				"label": {"type": "string"},
+				"admin": {"type": "boolean"},
+				"active": {"type": "boolean"},
+				"age": {"type": "integer"},
+				"score": {"type": "number"}
+			}
+		}`)
+		data := write("users.json", `[
+			{"name": "John", "label": "x", "admin": true, "active": false, "age": 30, "score": 1.5},
+			{"name": 42, "label": 1.5, "admin": 1, "active": "on", "age": "30", "score": "1.5"}
+		]`)
+
+		// act
+		code, stdout, _ := runCLI("-schema", schema, data)
+
+		// assert
+		Expect(code).To(Equal(1))
+		Expect(stdout).To(Equal(strings.Join([]string{
+			data + ": record 2: active: value is not a bool",
+			data + ": record 2: admin: value is not a bool",
+			data + ": record 2: age: value is not a number",
+			data + ": record 2: label: value is not a string",
+			data + ": record 2: name: value is not a string",
+			data + ": record 2: score: value is not a number",
+			"",
+		}, "\n")))
+	})
+
+	It("should refuse an unsupported JSON Schema keyword", func() {
+		// arrange
+		schema := write("schema.json", `{"type": "object", "properties": {"tags": {"type": "array"}}}`)
+		data := write("users.json", `{}`)
+
+		// act
+		code, _, stderr := runCLI("-schema", schema, data)
+
+		// assert
+		Expect(code).To(Equal(2))
+		Expect(stderr).To(ContainSubstring("property tags: unsupported type array"))
+	})
+
+	It("should refuse a schema for additional properties", func() {
+		// arrange
+		schema := write("schema.json", `{"type": "object", "properties": {}, "additionalProperties": {"type": "string"}}`)
+		data := write("users.json", `{"nickname": 42}`)
+
+		// act
+		code, _, stderr := runCLI("-schema", schema, data)
+
+		// assert
+		Expect(code).To(Equal(2))
+		Expect(stderr).To(ContainSubstring("unsupported keyword \"additionalProperties\""))
+	})
+
+	It("should pass additional properties through when they are allowed", func() {
+		// arrange
+		schema := write("schema.json", `{"type": "object", "properties": {}, "additionalProperties": true}`)
+		data := write("users.json", `{"nickname": 42}`)
+
+		// act
+		code, stdout, _ := runCLI("-schema", schema, data)
+
+		// assert
+		Expect(code).To(Equal(0))
+		Expect(stdout).To(BeEmpty())
+	})
+
+	It("should require a schema", func() {
+		// act
+		code, _, stderr := runCLI()
+
+		// assert
+		Expect(code).To(Equal(2))
+		Expect(stderr).To(ContainSubstring("-schema is required"))
+	})
+})
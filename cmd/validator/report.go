@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gungun974/validator"
+)
+
+type problem struct {
+	File    string `json:"file"`
+	Record  int    `json:"record"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// recordCoercion only parses times from strings, as JSON and YAML have no
+// time type. Any other value of the wrong type is a problem of the file.
+var recordCoercion = validator.WithCoercion(validator.Coercion{
+	StringToTime: true,
+	Bool:         validator.BoolStrictProfile,
+})
+
+func validateRecords(schema validator.Schema, file string, records []record) []problem {
+	var problems []problem
+	for _, r := range records {
+		value, ok := r.value.(map[string]any)
+		if !ok {
+			problems = append(problems, problem{File: file, Record: r.number, Message: "value is not an object"})
+			continue
+		}
+
+		_, err := schema.Validate(value, recordCoercion)
+		var errs validator.Errors
+		if errors.As(err, &errs) {
+			for _, fieldErr := range errs {
+				problems = append(problems, problem{
+					File:    file,
+					Record:  r.number,
+					Path:    strings.Join(fieldErr.Path, "."),
+					Message: fieldErr.Err.Error(),
+				})
+			}
+		} else if err != nil {
+			problems = append(problems, problem{File: file, Record: r.number, Message: err.Error()})
+		}
+	}
+	return problems
+}
+
+func writeProblems(w io.Writer, output string, problems []problem) error {
+	if output == "json" {
+		if problems == nil {
+			problems = []problem{}
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(problems)
+	}
+
+	for _, p := range problems {
+		location := fmt.Sprintf("%v: record %v", p.File, p.Record)
+		if p.Path != "" {
+			location += ": " + p.Path
+		}
+		if _, err := fmt.Fprintf(w, "%v: %v\n", location, p.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
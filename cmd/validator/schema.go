@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gungun974/validator"
+)
+
+func loadSchema(path string) (validator.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return validator.Schema{}, err
+	}
+
+	// YAML is a superset of JSON, so both schema formats are read alike.
+	var document map[string]any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return validator.Schema{}, fmt.Errorf("%v: %w", path, err)
+	}
+
+	var schema validator.Schema
+	if isJSONSchema(document) {
+		schema, err = jsonSchema(document)
+	} else {
+		schema, err = ownSchema(document)
+	}
+	if err != nil {
+		return validator.Schema{}, fmt.Errorf("%v: %w", path, err)
+	}
+	return schema, nil
+}
+
+func isJSONSchema(document map[string]any) bool {
+	_, hasSchema := document["$schema"]
+	_, hasProperties := document["properties"]
+	return hasSchema || hasProperties
+}
+
+func ownSchema(document map[string]any) (validator.Schema, error) {
+	var schema validator.Schema
+
+	for key, value := range document {
+		switch key {
+		case "fields", "required":
+		case "unknown_keys":
+			mode, err := unknownKeysMode(value)
+			if err != nil {
+				return validator.Schema{}, err
+			}
+			schema.UnknownKeys = mode
+			schema.SuggestKeys = mode == validator.UnknownKeysStrict
+		default:
+			return validator.Schema{}, fmt.Errorf("unknown schema key %q", key)
+		}
+	}
+
+	fields, ok := document["fields"].(map[string]any)
+	if !ok {
+		return validator.Schema{}, fmt.Errorf("schema has no fields")
+	}
+	for _, name := range sortedKeys(fields) {
+		switch value := fields[name].(type) {
+		case string:
+			set, err := validator.ParseRules(value)
+			if err != nil {
+				return validator.Schema{}, fmt.Errorf("field %v: %w", name, err)
+			}
+			schema.Fields = append(schema.Fields, set.Field(name))
+		case map[string]any:
+			nested, err := ownSchema(value)
+			if err != nil {
+				return validator.Schema{}, fmt.Errorf("field %v: %w", name, err)
+			}
+			field := validator.ObjectField(name, nested)
+			if required, _ := value["required"].(bool); !required {
+				field = field.Optional()
+			}
+			schema.Fields = append(schema.Fields, field)
+		default:
+			return validator.Schema{}, fmt.Errorf("field %v must be a rule string or a schema", name)
+		}
+	}
+
+	return schema, nil
+}
+
+func unknownKeysMode(value any) (validator.UnknownKeys, error) {
+	switch value {
+	case "strip":
+		return validator.UnknownKeysStrip, nil
+	case "strict":
+		return validator.UnknownKeysStrict, nil
+	case "passthrough":
+		return validator.UnknownKeysPassthrough, nil
+	}
+	return 0, fmt.Errorf("unknown_keys must be strip, strict or passthrough, not %v", value)
+}
+
+// jsonSchemaAnnotations are the JSON Schema keywords that do not constrain
+// values and are therefore ignored.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true,
+	"description": true, "examples": true, "default": true,
+}
+
+// jsonSchema converts the subset of JSON Schema the rules of the library can
+// check, refusing any other keyword rather than silently ignoring it.
+func jsonSchema(document map[string]any) (validator.Schema, error) {
+	var schema validator.Schema
+
+	required := map[string]bool{}
+	for key, value := range document {
+		switch {
+		case jsonSchemaAnnotations[key], key == "properties":
+		case key == "type":
+			if value != "object" {
+				return validator.Schema{}, fmt.Errorf("root type must be object, not %v", value)
+			}
+		case key == "required":
+			names, ok := value.([]any)
+			if !ok {
+				return validator.Schema{}, fmt.Errorf("required must be a list")
+			}
+			for _, name := range names {
+				required[fmt.Sprint(name)] = true
+			}
+		case key == "additionalProperties":
+			switch value {
+			case false:
+				schema.UnknownKeys = validator.UnknownKeysStrict
+				schema.SuggestKeys = true
+			case true:
+				schema.UnknownKeys = validator.UnknownKeysPassthrough
+			default:
+				// A schema for the additional properties would go unchecked.
+				return validator.Schema{}, fmt.Errorf("unsupported keyword %q", key)
+			}
+		default:
+			return validator.Schema{}, fmt.Errorf("unsupported keyword %q", key)
+		}
+	}
+
+	properties, _ := document["properties"].(map[string]any)
+	for _, name := range sortedKeys(properties) {
+		property, ok := properties[name].(map[string]any)
+		if !ok {
+			return validator.Schema{}, fmt.Errorf("property %v must be a schema", name)
+		}
+
+		field, err := jsonSchemaField(name, property)
+		if err != nil {
+			return validator.Schema{}, fmt.Errorf("property %v: %w", name, err)
+		}
+		if !required[name] {
+			field = field.Optional()
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+
+	return schema, nil
+}
+
+func jsonSchemaField(name string, property map[string]any) (validator.Field, error) {
+	if property["type"] == "object" {
+		nested, err := jsonSchema(property)
+		if err != nil {
+			return validator.Field{}, err
+		}
+		return validator.ObjectField(name, nested), nil
+	}
+
+	ruleType := "string"
+	var rules []string
+	for _, key := range sortedKeys(property) {
+		value := property[key]
+		switch {
+		case jsonSchemaAnnotations[key]:
+		case key == "type":
+			if _, ok := property["format"]; ok {
+				continue
+			}
+			t, ok := jsonSchemaTypes[fmt.Sprint(value)]
+			if !ok {
+				return validator.Field{}, fmt.Errorf("unsupported type %v", value)
+			}
+			ruleType = t
+		case key == "format":
+			if value == "email" {
+				rules = append(rules, "email")
+				continue
+			}
+			t, ok := jsonSchemaFormats[fmt.Sprint(value)]
+			if !ok {
+				return validator.Field{}, fmt.Errorf("unsupported format %v", value)
+			}
+			ruleType = t
+		case key == "minLength", key == "minimum":
+			rules = append(rules, fmt.Sprintf("min:%v", value))
+		case key == "maxLength", key == "maximum":
+			rules = append(rules, fmt.Sprintf("max:%v", value))
+		default:
+			return validator.Field{}, fmt.Errorf("unsupported keyword %q", key)
+		}
+	}
+
+	set, err := validator.ParseRules(strings.Join(append([]string{"required", ruleType}, rules...), "|"))
+	if err != nil {
+		return validator.Field{}, err
+	}
+	return set.Field(name), nil
+}
+
+var jsonSchemaTypes = map[string]string{
+	"string":  "string",
+	"integer": "int",
+	"number":  "float",
+	"boolean": "bool",
+}
+
+// jsonSchemaFormats are the string formats read as a value type of their own.
+var jsonSchemaFormats = map[string]string{
+	"uuid":      "uuid",
+	"date":      "date",
+	"date-time": "time",
+	"duration":  "duration",
+}
+
+func sortedKeys(value map[string]any) []string {
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Codes of the errors returned by the Validate* functions, read with
+// ErrorCode. Unlike the messages, they are stable.
+const (
+	CodeRequired   = "required"
+	CodeNull       = "null"
+	CodeUnknownKey = "unknown_key"
+	CodeType       = "type"
+	CodeNotFound   = "not_found"
+	CodeTaken      = "taken"
+	CodeInvalid    = "invalid"
+)
+
+// CodeError is an error with a code, such as CodeRequired for a missing key.
+type CodeError struct {
+	Code string
+	Err  error
+}
+
+func (e *CodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CodeError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode returns the code of err, CodeInvalid for a rule without a code.
+func ErrorCode(err error) string {
+	var codeErr *CodeError
+	if errors.As(err, &codeErr) {
+		return codeErr.Code
+	}
+	return CodeInvalid
+}
+
+func missingKeyError(name string) error {
+	return &CodeError{Code: CodeRequired, Err: fmt.Errorf("missing key \"%v\"", name)}
+}
+
+// typeError reports a value of the wrong type or format for its Validate*
+// function, before any rule runs.
+func typeError(message string) error {
+	return &CodeError{Code: CodeType, Err: errors.New(message)}
+}
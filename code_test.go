@@ -0,0 +1,78 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func errorCodeTests() {
+	DescribeTable("should return the code of an error",
+		func(validate func() error, code string) {
+			// act
+			err := validate()
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(validator.ErrorCode(err)).To(Equal(code))
+		},
+		Entry("a missing key", func() error {
+			_, err := validator.ValidateMapString("name", map[string]any{}, validator.StringValidators{})
+			return err
+		}, validator.CodeRequired),
+		Entry("a conditionally required key", func() error {
+			_, err := validator.ValidateMapStringOrNil("name", map[string]any{"mode": "full"}, validator.StringValidators{},
+				validator.WithPresence(validator.PresenceValidators{validator.RequiredIfValidator{Key: "mode", Value: "full"}}))
+			return err
+		}, validator.CodeRequired),
+		Entry("a rejected null", func() error {
+			_, err := validator.ValidateMapStringOrNil("name", map[string]any{"name": nil}, validator.StringValidators{},
+				validator.WithRejectNull())
+			return err
+		}, validator.CodeNull),
+		Entry("an unknown key", func() error {
+			return validator.ValidateKnownKeys(map[string]any{"nmae": "John"}, []string{"name"}, true)
+		}, validator.CodeUnknownKey),
+		Entry("a value of the wrong type", func() error {
+			_, err := validator.ValidateInt("42", validator.IntValidators{})
+			return err
+		}, validator.CodeType),
+		Entry("a missing value", func() error {
+			_, err := validator.ValidateString("ghost", validator.StringValidators{validator.StringExistsValidator{
+				Lookup: validator.NewMemoryLookup(),
+			}})
+			return err
+		}, validator.CodeNotFound),
+		Entry("a failed rule", func() error {
+			_, err := validator.ValidateString("john", validator.StringValidators{validator.StringEmailValidator{}})
+			return err
+		}, validator.CodeInvalid),
+		Entry("a failed rule with a type-like message", func() error {
+			_, err := validator.ValidateUUIDWith(uuid.New(), validator.UUIDValidators{validator.UUIDTimeMinValidator{}})
+			return err
+		}, validator.CodeInvalid),
+		Entry("an error without a code", func() error {
+			return errors.New("boom")
+		}, validator.CodeInvalid),
+	)
+
+	It("should keep the code of a field error", func() {
+		// arrange
+		schema := validator.Schema{Fields: []validator.Field{
+			validator.StringField("name", validator.StringValidators{}),
+		}}
+
+		// act
+		_, err := schema.ValidateContext(context.Background(), map[string]any{})
+
+		// assert
+		var errs validator.Errors
+		Expect(errors.As(err, &errs)).To(BeTrue())
+		Expect(validator.ErrorCode(errs[0].Err)).To(Equal(validator.CodeRequired))
+	})
+}
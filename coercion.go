@@ -0,0 +1,91 @@
+package validator
+
+import "strconv"
+
+// Coercion decides which conversions between strings, numbers, bools and
+// times the Validate* functions perform. Without a Coercion each function
+// keeps its own historical conversions.
+type Coercion struct {
+	// StringToNumber lets ValidateInt and ValidateFloat parse numeric strings.
+	StringToNumber bool
+	// NumberToString lets ValidateString format ints and floats.
+	NumberToString bool
+	// StringToTime lets times, durations, dates, times of day and year months be parsed from strings.
+	StringToTime bool
+	// NumberToTime reads numbers as Unix seconds in ValidateTime and as seconds in ValidateDuration.
+	NumberToTime bool
+	// Bool decides which inputs ValidateBool accepts.
+	Bool BoolProfile
+}
+
+var (
+	// CoercionStrict only accepts inputs already of the expected type.
+	CoercionStrict = Coercion{
+		Bool: BoolStrictProfile,
+	}
+	// CoercionJSON accepts what JSON clients commonly send: times as
+	// strings, numbers as strings and the other way around.
+	CoercionJSON = Coercion{
+		StringToNumber: true,
+		NumberToString: true,
+		StringToTime:   true,
+		Bool: BoolProfile{
+			Truthy: []string{"true"},
+			Falsy:  []string{"false"},
+		},
+	}
+	// CoercionForm accepts HTML form values, where everything is a string.
+	CoercionForm = Coercion{
+		StringToNumber: true,
+		NumberToString: true,
+		StringToTime:   true,
+		Bool:           BoolCheckboxProfile,
+	}
+)
+
+// WithCoercion sets the conversions performed by every Validate* function.
+// A later WithBoolProfile or WithEpoch still overrides its bool and epoch handling.
+func WithCoercion(coercion Coercion) Option {
+	return func(o *options) {
+		o.coercion = &coercion
+		o.boolProfile = coercion.Bool
+	}
+}
+
+func (o options) stringToNumber() bool {
+	return o.coercion != nil && o.coercion.StringToNumber
+}
+
+func (o options) numberToString() bool {
+	return o.coercion == nil || o.coercion.NumberToString
+}
+
+func (o options) stringToTime() bool {
+	return o.coercion == nil || o.coercion.StringToTime
+}
+
+func (o options) numberToDuration() bool {
+	return o.coercion == nil || o.coercion.NumberToTime
+}
+
+func (o options) epochUnit() EpochUnit {
+	if o.epoch == EpochNone && o.coercion != nil && o.coercion.NumberToTime {
+		return EpochSeconds
+	}
+	return o.epoch
+}
+
+func parseNumberString(value any) any {
+	stringValue, stringOk := value.(string)
+	if !stringOk {
+		return value
+	}
+
+	if intValue, err := strconv.Atoi(stringValue); err == nil {
+		return intValue
+	}
+	if floatValue, err := strconv.ParseFloat(stringValue, 64); err == nil {
+		return floatValue
+	}
+	return value
+}
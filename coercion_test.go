@@ -0,0 +1,137 @@
+package validator_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func coercionTests() {
+	Describe("CoercionStrict", func() {
+		strict := validator.WithCoercion(validator.CoercionStrict)
+
+		It("should not convert a number into a string", func() {
+			// act
+			_, err := validator.ValidateString(42, validator.StringValidators{}, strict)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a string"))
+		})
+
+		It("should not parse a string into an int", func() {
+			// act
+			_, err := validator.ValidateInt("42", validator.IntValidators{}, strict)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a number"))
+		})
+
+		It("should not parse a string into a bool", func() {
+			// act
+			_, err := validator.ValidateBool("true", validator.BoolValidators{}, strict)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a bool"))
+		})
+
+		It("should not parse a string into a time", func() {
+			// act
+			_, err := validator.ValidateTime("2023-12-24", validator.TimeValidators{}, strict)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a time"))
+		})
+
+		It("should not read a number as a duration", func() {
+			// act
+			_, err := validator.ValidateDuration(30, validator.DurationValidators{}, strict)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a duration"))
+		})
+
+		It("should still accept the expected types", func() {
+			// act
+			result, err := validator.ValidateInt(42.0, validator.IntValidators{}, strict)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(42))
+		})
+	})
+
+	Describe("CoercionJSON", func() {
+		json := validator.WithCoercion(validator.CoercionJSON)
+
+		It("should parse a numeric string into a float", func() {
+			// act
+			result, err := validator.ValidateFloat("4.2", validator.FloatValidators{}, json)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(4.2))
+		})
+
+		It("should only read true and false strings as bools", func() {
+			// act
+			_, err := validator.ValidateBool("on", validator.BoolValidators{}, json)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a bool"))
+		})
+	})
+
+	Describe("CoercionForm", func() {
+		form := validator.WithCoercion(validator.CoercionForm)
+
+		It("should parse a string into an int", func() {
+			// act
+			result, err := validator.ValidateInt("42", validator.IntValidators{}, form)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(42))
+		})
+
+		It("should read a missing checkbox as false", func() {
+			// act
+			result, err := validator.ValidateMapBool("newsletter", map[string]any{}, validator.BoolValidators{}, form)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeFalse())
+		})
+	})
+
+	Describe("custom Coercion", func() {
+		It("should read numbers as Unix seconds", func() {
+			// act
+			result, err := validator.ValidateTime(1703394000, validator.TimeValidators{},
+				validator.WithCoercion(validator.Coercion{NumberToTime: true}))
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(time.Date(2023, 12, 24, 5, 0, 0, 0, time.UTC)))
+		})
+
+		It("should let a later bool profile override the Coercion", func() {
+			// act
+			result, err := validator.ValidateBool("yes", validator.BoolValidators{},
+				validator.WithCoercion(validator.CoercionStrict),
+				validator.WithBoolProfile(validator.BoolPermissiveProfile))
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeTrue())
+		})
+	})
+}
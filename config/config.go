@@ -0,0 +1,334 @@
+// Package config validates the settings of a service at startup, read from
+// environment variables and configuration files, and reports every missing or
+// invalid setting at once with the values of secrets redacted.
+//
+//	settings, err := config.Load(schema,
+//		config.WithFile("config.yaml"),
+//		config.WithEnv("APP_"),
+//	)
+//
+// With the prefix "APP_", APP_DB__PORT=5432 sets the port key of the db
+// object: the prefix is stripped, the name lowercased and "__" nests keys.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/gungun974/validator"
+)
+
+// Coercion reads settings the way they are written in environment variables
+// and files, where numbers, bools, times and durations are often strings.
+var Coercion = validator.Coercion{
+	StringToNumber: true,
+	NumberToString: true,
+	StringToTime:   true,
+	Bool:           validator.BoolPermissiveProfile,
+}
+
+// DefaultSecrets are the key names whose values are redacted from reports,
+// in addition to the paths given to WithSecrets.
+var DefaultSecrets = []string{"password", "secret", "token", "api_key", "private_key"}
+
+const redacted = "[REDACTED]"
+
+type Option func(*loader)
+
+type loader struct {
+	sources   []func(settings map[string]any) error
+	env       bool
+	environ   []string
+	envPrefix string
+	secrets   []string
+	opts      []validator.Option
+}
+
+// WithEnv reads the environment variables starting with prefix. Later
+// sources override the settings of earlier ones.
+func WithEnv(prefix string) Option {
+	return func(l *loader) {
+		l.env = true
+		l.envPrefix = prefix
+		l.sources = append(l.sources, func(settings map[string]any) error {
+			environ := l.environ
+			if environ == nil {
+				environ = os.Environ()
+			}
+			for _, entry := range environ {
+				name, value, _ := strings.Cut(entry, "=")
+				if !strings.HasPrefix(name, prefix) || name == prefix {
+					continue
+				}
+				path := strings.Split(strings.ToLower(strings.TrimPrefix(name, prefix)), "__")
+				setPath(settings, path, value)
+			}
+			return nil
+		})
+	}
+}
+
+// WithEnviron replaces os.Environ as the environment read by WithEnv.
+func WithEnviron(environ []string) Option {
+	return func(l *loader) {
+		l.environ = environ
+	}
+}
+
+// WithFile reads a YAML, TOML or JSON file, picked by its extension.
+func WithFile(path string) Option {
+	return func(l *loader) {
+		l.sources = append(l.sources, func(settings map[string]any) error {
+			values, err := readFile(path)
+			if err != nil {
+				return err
+			}
+			merge(settings, values)
+			return nil
+		})
+	}
+}
+
+// WithSecrets redacts the values at paths, such as "db.password", from reports.
+func WithSecrets(paths ...string) Option {
+	return func(l *loader) {
+		l.secrets = append(l.secrets, paths...)
+	}
+}
+
+// WithValidatorOptions adds options to the validation of the settings, after
+// WithCoercion(Coercion).
+func WithValidatorOptions(opts ...validator.Option) Option {
+	return func(l *loader) {
+		l.opts = append(l.opts, opts...)
+	}
+}
+
+// Load reads the settings from every source and validates them with schema.
+// Its error, when the settings are invalid, is an *Error.
+func Load(schema validator.Schema, opts ...Option) (map[string]any, error) {
+	l := loader{}
+	for _, opt := range opts {
+		opt(&l)
+	}
+
+	settings := map[string]any{}
+	for _, source := range l.sources {
+		if err := source(settings); err != nil {
+			return nil, err
+		}
+	}
+
+	validatorOpts := append([]validator.Option{validator.WithCoercion(Coercion)}, l.opts...)
+	result, err := schema.Validate(settings, validatorOpts...)
+	var errs validator.Errors
+	if errors.As(err, &errs) {
+		return nil, l.report(settings, errs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MustLoad is Load panicking with the report when the settings are invalid,
+// to fail fast at startup.
+func MustLoad(schema validator.Schema, opts ...Option) map[string]any {
+	settings, err := Load(schema, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return settings
+}
+
+// Problem is a missing or invalid setting.
+type Problem struct {
+	Path    string
+	Message string
+	// Value is the value read for the setting, redacted for secrets, or nil
+	// when the setting is missing.
+	Value any
+	// Env is the environment variable setting it, when WithEnv is used.
+	Env string
+}
+
+// Error is the report of every missing or invalid setting.
+type Error struct {
+	Problems []Problem
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid configuration:")
+	for _, p := range e.Problems {
+		fmt.Fprintf(&b, "\n  %v: %v", p.Path, p.Message)
+		if p.Value != nil {
+			fmt.Fprintf(&b, " (got %q)", fmt.Sprint(p.Value))
+		}
+		if p.Env != "" {
+			fmt.Fprintf(&b, " [%v]", p.Env)
+		}
+	}
+	return b.String()
+}
+
+func (l loader) report(settings map[string]any, errs validator.Errors) *Error {
+	report := &Error{}
+	for _, fieldErr := range errs {
+		p := Problem{
+			Path:    strings.Join(fieldErr.Path, "."),
+			Message: fieldErr.Err.Error(),
+		}
+		// Errors of the schema rules are about several settings, so they
+		// have no path, value or variable of their own.
+		if len(fieldErr.Path) > 0 {
+			if value, ok := lookupPath(settings, fieldErr.Path); ok {
+				p.Value = l.redact(fieldErr.Path, value)
+			}
+			if l.env {
+				p.Env = l.envPrefix + strings.ToUpper(strings.Join(fieldErr.Path, "__"))
+			}
+		}
+		report.Problems = append(report.Problems, p)
+	}
+	sort.SliceStable(report.Problems, func(i, j int) bool {
+		return report.Problems[i].Path < report.Problems[j].Path
+	})
+	return report
+}
+
+// redact replaces value with "[REDACTED]" when path is a secret, and
+// otherwise the secrets nested in it.
+func (l loader) redact(path []string, value any) any {
+	if l.isSecret(path) {
+		return redacted
+	}
+	switch value := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(value))
+		for key, item := range value {
+			copied[key] = l.redact(append(path[:len(path):len(path)], key), item)
+		}
+		return copied
+	case []any:
+		copied := make([]any, 0, len(value))
+		for i, item := range value {
+			copied = append(copied, l.redact(append(path[:len(path):len(path)], strconv.Itoa(i)), item))
+		}
+		return copied
+	}
+	return value
+}
+
+func (l loader) isSecret(path []string) bool {
+	joined := strings.Join(path, ".")
+	for _, secret := range l.secrets {
+		if joined == secret {
+			return true
+		}
+	}
+	name := strings.ToLower(path[len(path)-1])
+	for _, secret := range DefaultSecrets {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+func readFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		err = toml.Unmarshal(data, &values)
+	case ".json":
+		err = json.Unmarshal(data, &values)
+	default:
+		return nil, fmt.Errorf("%v: unknown configuration file format", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", path, err)
+	}
+	return normalize(values).(map[string]any), nil
+}
+
+// normalize converts the values decoded from files to the types read by the
+// Validate functions, such as the int64 of TOML integers.
+func normalize(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, item := range value {
+			value[key] = normalize(item)
+		}
+		return value
+	case []any:
+		for i, item := range value {
+			value[i] = normalize(item)
+		}
+		return value
+	case []map[string]any:
+		items := make([]any, 0, len(value))
+		for _, item := range value {
+			items = append(items, normalize(item))
+		}
+		return items
+	case int64:
+		return int(value)
+	}
+	return value
+}
+
+func setPath(settings map[string]any, path []string, value any) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := settings[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			settings[key] = next
+		}
+		settings = next
+	}
+	settings[path[len(path)-1]] = value
+}
+
+func merge(settings, values map[string]any) {
+	for key, value := range values {
+		nested, isMap := value.(map[string]any)
+		existing, hasMap := settings[key].(map[string]any)
+		if isMap && hasMap {
+			merge(existing, nested)
+			continue
+		}
+		settings[key] = value
+	}
+}
+
+func lookupPath(settings map[string]any, path []string) (any, bool) {
+	var value any = settings
+	for _, key := range path {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		value, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,205 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gungun974/validator"
+	"github.com/gungun974/validator/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Config")
+}
+
+var schema = validator.Schema{
+	Fields: []validator.Field{
+		validator.StringField("name", validator.StringValidators{}),
+		validator.BoolField("debug", validator.BoolValidators{}).Optional(),
+		validator.DurationField("timeout", validator.DurationValidators{}).Optional(),
+		validator.ObjectField("db", validator.Schema{
+			Fields: []validator.Field{
+				validator.StringField("host", validator.StringValidators{}),
+				validator.IntField("port", validator.IntValidators{validator.IntMaxValidator{Max: 65535}}),
+				validator.StringField("password", validator.StringValidators{validator.StringMinValidator{Min: 12}}),
+			},
+		}),
+	},
+}
+
+var _ = Describe("Load", func() {
+	var dir string
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("should read nested settings from the environment", func() {
+		// arrange
+		environ := []string{
+			"APP_NAME=billing",
+			"APP_DEBUG=yes",
+			"APP_TIMEOUT=30s",
+			"APP_DB__HOST=localhost",
+			"APP_DB__PORT=5432",
+			"APP_DB__PASSWORD=correct horse battery",
+			"HOME=/root",
+		}
+
+		// act
+		settings, err := config.Load(schema, config.WithEnv("APP_"), config.WithEnviron(environ))
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(settings).To(Equal(map[string]any{
+			"name":    "billing",
+			"debug":   true,
+			"timeout": 30 * time.Second,
+			"db": map[string]any{
+				"host":     "localhost",
+				"port":     5432,
+				"password": "correct horse battery",
+			},
+		}))
+	})
+
+	It("should let the environment override a file", func() {
+		// arrange
+		file := write("config.toml", "name = \"billing\"\n\n[db]\nhost = \"db.internal\"\nport = 5432\npassword = \"correct horse battery\"\n")
+		environ := []string{"APP_DB__HOST=localhost"}
+
+		// act
+		settings, err := config.Load(
+			schema,
+			config.WithFile(file),
+			config.WithEnv("APP_"),
+			config.WithEnviron(environ),
+		)
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(settings["db"]).To(Equal(map[string]any{
+			"host":     "localhost",
+			"port":     5432,
+			"password": "correct horse battery",
+		}))
+	})
+
+	It("should read YAML and JSON files", func() {
+		// arrange
+		yamlFile := write("config.yaml", "name: billing\ndb:\n  host: localhost\n  port: 5432\n")
+		jsonFile := write("secrets.json", `{"db": {"password": "correct horse battery"}}`)
+
+		// act
+		settings, err := config.Load(schema, config.WithFile(yamlFile), config.WithFile(jsonFile))
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(settings["db"]).To(HaveKeyWithValue("port", 5432))
+		Expect(settings["db"]).To(HaveKeyWithValue("password", "correct horse battery"))
+	})
+
+	It("should report every invalid setting with secrets redacted", func() {
+		// arrange
+		environ := []string{
+			"APP_DB__PORT=99999",
+			"APP_DB__PASSWORD=hunter2",
+			"APP_DB__HOST=localhost",
+		}
+
+		// act
+		_, err := config.Load(schema, config.WithEnv("APP_"), config.WithEnviron(environ))
+
+		// assert
+		var configErr *config.Error
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(err.Error()).To(Equal(`invalid configuration:
+  db.password: value length must not be greater than 12 (got "[REDACTED]") [APP_DB__PASSWORD]
+  db.port: value must not be greater than 65535 (got "99999") [APP_DB__PORT]
+  name: missing key "name" [APP_NAME]`))
+	})
+
+	It("should redact the paths given to WithSecrets", func() {
+		// arrange
+		schema := validator.Schema{
+			Fields: []validator.Field{
+				validator.IntField("pin", validator.IntValidators{}),
+			},
+		}
+		file := write("config.json", `{"pin": "12a4"}`)
+
+		// act
+		_, err := config.Load(schema, config.WithFile(file), config.WithSecrets("pin"))
+
+		// assert
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(Equal("invalid configuration:\n  pin: value is not a number (got \"[REDACTED]\")"))
+	})
+
+	It("should report a schema rule without leaking the settings", func() {
+		// arrange
+		schema := validator.Schema{
+			Fields: []validator.Field{
+				validator.StringField("db_password", validator.StringValidators{}).Optional(),
+				validator.StringField("api_token", validator.StringValidators{}).Optional(),
+			},
+			Rules: validator.ObjectValidators{
+				validator.ExactlyOneOfValidator{Fields: []string{"db_password", "api_token"}},
+			},
+		}
+		environ := []string{"APP_DB_PASSWORD=hunter2", "APP_API_TOKEN=s3cr3t"}
+
+		// act
+		_, err := config.Load(schema, config.WithEnv("APP_"), config.WithEnviron(environ))
+
+		// assert
+		var configErr *config.Error
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.Problems).To(Equal([]config.Problem{
+			{Message: "exactly one of db_password, api_token must be present"},
+		}))
+		Expect(err.Error()).NotTo(ContainSubstring("hunter2"))
+	})
+
+	It("should redact the secrets nested in an invalid setting", func() {
+		// arrange
+		schema := validator.Schema{
+			Fields: []validator.Field{
+				validator.StringField("db", validator.StringValidators{}),
+			},
+		}
+		environ := []string{"APP_DB__PASSWORD=hunter2hunter2", "APP_DB__HOST=localhost"}
+
+		// act
+		_, err := config.Load(schema, config.WithEnv("APP_"), config.WithEnviron(environ))
+
+		// assert
+		var configErr *config.Error
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.Problems[0].Value).To(Equal(map[string]any{"password": "[REDACTED]", "host": "localhost"}))
+		Expect(err.Error()).NotTo(ContainSubstring("hunter2hunter2"))
+	})
+
+	It("should panic with the report from MustLoad", func() {
+		// act
+		act := func() {
+			config.MustLoad(schema, config.WithEnv("APP_"), config.WithEnviron([]string{}))
+		}
+
+		// assert
+		Expect(act).To(PanicWith(BeAssignableToTypeOf(&config.Error{})))
+	})
+})
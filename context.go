@@ -0,0 +1,49 @@
+package validator
+
+import "context"
+
+// WithContext sets the context handed to context-aware rules. Validation stops
+// with a LookupError as soon as ctx is done.
+func WithContext(ctx context.Context) Option {
+	return func(o *options) {
+		o.ctx = ctx
+	}
+}
+
+func (o options) context() context.Context {
+	ctx := o.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if o.clock != nil {
+		ctx = ContextWithClock(ctx, o.clock)
+	}
+	return ctx
+}
+
+// applyRules runs every rule against value, preferring ValidateContext when a
+// rule implements it.
+func applyRules[T any, R interface{ Validate(value T) error }](
+	ctx context.Context,
+	rules []R,
+	value T,
+) error {
+	for _, rule := range rules {
+		if err := ctx.Err(); err != nil {
+			return &LookupError{Err: err}
+		}
+
+		var err error
+		if contextRule, ok := any(rule).(interface {
+			ValidateContext(ctx context.Context, value T) error
+		}); ok {
+			err = contextRule.ValidateContext(ctx, value)
+		} else {
+			err = rule.Validate(value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
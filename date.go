@@ -0,0 +1,260 @@
+package validator
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Date is a calendar day without a time or a location, unlike time.Time
+// which is an instant.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+func ParseDate(value string) (Date, error) {
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsValid reports whether the day exists, unlike February 30.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In returns the instant at which the day starts in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+func (d Date) AddDays(days int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, days))
+}
+
+func (d Date) Compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return compareInts(d.Year, other.Year)
+	case d.Month != other.Month:
+		return compareInts(int(d.Month), int(other.Month))
+	default:
+		return compareInts(d.Day, other.Day)
+	}
+}
+
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	date, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d *Date) Scan(src any) error {
+	switch src := src.(type) {
+	case time.Time:
+		*d = DateOf(src)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(src))
+	case []byte:
+		return d.UnmarshalText(src)
+	}
+	return fmt.Errorf("cannot scan %T into a Date", src)
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type DateValidators []dateValidator
+
+type dateValidator interface {
+	Validate(value Date) error
+}
+
+type DateMaxValidator struct {
+	Max Date
+}
+
+func (v DateMaxValidator) Validate(value Date) error {
+	if value.After(v.Max) {
+		return fmt.Errorf("value must not be after %v", v.Max)
+	}
+	return nil
+}
+
+func (v DateMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "date.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("on or before %v", v.Max),
+	}
+}
+
+type DateMinValidator struct {
+	Min Date
+}
+
+func (v DateMinValidator) Validate(value Date) error {
+	if value.Before(v.Min) {
+		return fmt.Errorf("value must not be before %v", v.Min)
+	}
+	return nil
+}
+
+func (v DateMinValidator) Describe() Description {
+	return Description{
+		Kind:    "date.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("on or after %v", v.Min),
+	}
+}
+
+type DateWeekdayValidator struct {
+	Weekdays []time.Weekday
+}
+
+func (v DateWeekdayValidator) Validate(value Date) error {
+	weekday := value.Weekday()
+	names := make([]string, 0, len(v.Weekdays))
+	for _, allowed := range v.Weekdays {
+		if weekday == allowed {
+			return nil
+		}
+		names = append(names, allowed.String())
+	}
+	return fmt.Errorf("value must fall on %v", strings.Join(names, ", "))
+}
+
+func (v DateWeekdayValidator) Describe() Description {
+	return Description{
+		Kind:    "date.weekday",
+		Params:  map[string]any{"weekdays": v.Weekdays},
+		Summary: fmt.Sprintf("on a %v", joinValues(v.Weekdays)),
+	}
+}
+
+func ValidateMapDate(
+	name string,
+	value map[string]any,
+	rules DateValidators,
+	opts ...Option,
+) (Date, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return Date{}, err
+	}
+	if !ok {
+		return Date{}, missingKeyError(name)
+	}
+	return ValidateDate(rawValue, rules, opts...)
+}
+
+func ValidateMapDateOrNil(
+	name string,
+	value map[string]any,
+	rules DateValidators,
+	opts ...Option,
+) (*Date, error) {
+	return validateMapOrNil(name, value, ValidateDate, rules, opts)
+}
+
+func ValidateMapDateOrDefault(
+	name string,
+	value map[string]any,
+	rules DateValidators,
+	defaultValue Date,
+	opts ...Option,
+) (Date, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateDate, rules, func() Date {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapDateOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules DateValidators,
+	defaultValue func() Date,
+	opts ...Option,
+) (Date, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateDate, rules, defaultValue, opts)
+}
+
+func ValidateDate(value any, rules DateValidators, opts ...Option) (Date, error) {
+	o := newOptions(opts)
+
+	var dateValue Date
+
+	switch value := value.(type) {
+	case Date:
+		if !value.IsValid() {
+			return Date{}, typeError("value is not a date")
+		}
+		dateValue = value
+	case time.Time:
+		dateValue = DateOf(value)
+	case string:
+		date, err := ParseDate(value)
+		if err != nil || !o.stringToTime() {
+			return Date{}, typeError("value is not a date")
+		}
+		dateValue = date
+	default:
+		return Date{}, typeError("value is not a date")
+	}
+
+	if err := applyRules(o.context(), rules, dateValue); err != nil {
+		return Date{}, err
+	}
+
+	return dateValue, nil
+}
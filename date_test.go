@@ -0,0 +1,154 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func dateValidatorTests() {
+	Describe("ValidateDate", func() {
+		DescribeTable("should return a Date",
+			func(value any) {
+				// act
+				result, err := validator.ValidateDate(value, validator.DateValidators{})
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(validator.Date{Year: 2024, Month: time.May, Day: 3}))
+			},
+			Entry("from a Date", validator.Date{Year: 2024, Month: time.May, Day: 3}),
+			Entry("from a string", "2024-05-03"),
+			Entry("from a Time in its own location",
+				time.Date(2024, 5, 3, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*60*60))),
+		)
+
+		DescribeTable("should not return a Date when input is garbage",
+			func(value any) {
+				// act
+				_, err := validator.ValidateDate(value, validator.DateValidators{})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a date"))
+			},
+			Entry("an int", 42),
+			Entry("a date-time string", "2024-05-03T10:00:00Z"),
+			Entry("an impossible date", "2024-02-30"),
+			Entry("an impossible Date", validator.Date{Year: 2023, Month: time.February, Day: 30}),
+			Entry("a zero Date", validator.Date{}),
+		)
+
+		It("should not return a Date when it is before min", func() {
+			// arrange
+			value := "2024-05-03"
+
+			// act
+			_, err := validator.ValidateDate(value, validator.DateValidators{
+				validator.DateMinValidator{Min: validator.Date{Year: 2024, Month: time.May, Day: 4}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be before 2024-05-04"))
+		})
+
+		It("should not return a Date when it is after max", func() {
+			// arrange
+			value := "2024-05-03"
+
+			// act
+			_, err := validator.ValidateDate(value, validator.DateValidators{
+				validator.DateMaxValidator{Max: validator.Date{Year: 2024, Month: time.May, Day: 2}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be after 2024-05-02"))
+		})
+
+		It("should return a Date when it falls on an allowed weekday", func() {
+			// arrange
+			value := "2024-05-03"
+
+			// act
+			result, err := validator.ValidateDate(value, validator.DateValidators{
+				validator.DateWeekdayValidator{Weekdays: []time.Weekday{time.Thursday, time.Friday}},
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Weekday()).To(Equal(time.Friday))
+		})
+
+		It("should not return a Date when it falls on a weekend", func() {
+			// arrange
+			value := "2024-05-04"
+
+			// act
+			_, err := validator.ValidateDate(value, validator.DateValidators{
+				validator.DateWeekdayValidator{Weekdays: []time.Weekday{time.Monday, time.Friday}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must fall on Monday, Friday"))
+		})
+	})
+
+	Describe("Date", func() {
+		It("should encode and decode JSON as a string", func() {
+			// arrange
+			value := struct {
+				Birthday validator.Date `json:"birthday"`
+			}{validator.Date{Year: 2024, Month: time.May, Day: 3}}
+
+			// act
+			data, err := json.Marshal(value)
+			Expect(err).ShouldNot(HaveOccurred())
+			value.Birthday = validator.Date{}
+			err = json.Unmarshal(data, &value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`{"birthday":"2024-05-03"}`))
+			Expect(value.Birthday).To(Equal(validator.Date{Year: 2024, Month: time.May, Day: 3}))
+		})
+
+		It("should scan a SQL time", func() {
+			// arrange
+			var date validator.Date
+
+			// act
+			err := date.Scan(time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(date).To(Equal(validator.Date{Year: 2024, Month: time.May, Day: 3}))
+		})
+
+		It("should return its SQL value as a string", func() {
+			// act
+			result, err := validator.Date{Year: 2024, Month: time.May, Day: 3}.Value()
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal("2024-05-03"))
+		})
+
+		It("should start at midnight in the given location", func() {
+			// arrange
+			location := time.FixedZone("UTC+9", 9*60*60)
+
+			// act
+			result := validator.Date{Year: 2024, Month: time.May, Day: 3}.In(location)
+
+			// assert
+			Expect(result).To(Equal(time.Date(2024, 5, 3, 0, 0, 0, 0, location)))
+		})
+	})
+}
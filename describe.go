@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Description is the structured form of a rule, a value type or a schema,
+// meant for documentation, form hints and tooling.
+type Description struct {
+	// Kind names the rule, such as "string.max", or the type of a field.
+	Kind    string         `json:"kind"`
+	Params  map[string]any `json:"params,omitempty"`
+	Summary string         `json:"summary,omitempty"`
+	// Rules holds the rules of a field or the operands of a combinator.
+	Rules  []Description      `json:"rules,omitempty"`
+	Fields []FieldDescription `json:"fields,omitempty"`
+}
+
+type FieldDescription struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	// Presence describes the rules deciding whether an optional field is
+	// required or forbidden.
+	Presence []Description `json:"presence,omitempty"`
+	Description
+}
+
+// Describer is implemented by every built-in rule.
+type Describer interface {
+	Describe() Description
+}
+
+// DescribeRules describes every rule of a rule slice such as StringValidators,
+// a rule without a Describe method being of the "custom" kind.
+func DescribeRules[R any](rules []R) []Description {
+	descriptions := make([]Description, 0, len(rules))
+	for _, rule := range rules {
+		if describer, ok := any(rule).(Describer); ok {
+			descriptions = append(descriptions, describer.Describe())
+		} else {
+			descriptions = append(descriptions, Description{Kind: "custom"})
+		}
+	}
+	return descriptions
+}
+
+// Summary joins the summaries of descriptions into one sentence, such as
+// "At least 3 characters, must be an email".
+func Summary(descriptions []Description) string {
+	return capitalize(joinSummaries(descriptions, ", "))
+}
+
+// Describe describes the fields of the schema and its object rules.
+func (s Schema) Describe() Description {
+	fields := make([]FieldDescription, 0, len(s.Fields))
+	for _, field := range s.Fields {
+		fieldDescription := FieldDescription{
+			Name:        field.Name,
+			Required:    field.Required,
+			Description: field.Description,
+		}
+		if len(field.Presence) > 0 {
+			fieldDescription.Presence = DescribeRules(field.Presence)
+		}
+		fields = append(fields, fieldDescription)
+	}
+
+	rules := DescribeRules(s.Rules)
+	return Description{
+		Kind:    "object",
+		Summary: joinSummaries(rules, ", "),
+		Rules:   rules,
+		Fields:  fields,
+	}
+}
+
+func describeField[R any](kind string, rules []R) Description {
+	descriptions := DescribeRules(rules)
+	return Description{
+		Kind:    kind,
+		Summary: joinSummaries(descriptions, ", "),
+		Rules:   descriptions,
+	}
+}
+
+func joinSummaries(descriptions []Description, sep string) string {
+	summaries := make([]string, 0, len(descriptions))
+	for _, description := range descriptions {
+		if description.Summary != "" {
+			summaries = append(summaries, description.Summary)
+		}
+	}
+	return strings.Join(summaries, sep)
+}
+
+func capitalize(value string) string {
+	r, size := utf8.DecodeRuneInString(value)
+	if r == utf8.RuneError {
+		return value
+	}
+	return string(unicode.ToUpper(r)) + value[size:]
+}
+
+func joinValues[T any](values []T) string {
+	texts := make([]string, 0, len(values))
+	for _, value := range values {
+		texts = append(texts, fmt.Sprint(value))
+	}
+	return strings.Join(texts, ", ")
+}
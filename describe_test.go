@@ -0,0 +1,157 @@
+package validator_test
+
+import (
+	"encoding/json"
+
+	"github.com/gungun974/validator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func describeTests() {
+	Describe("DescribeRules", func() {
+		It("should describe every built-in rule", func() {
+			// act
+			descriptions := validator.DescribeRules(validator.StringValidators{
+				validator.StringMinValidator{Min: 3},
+				validator.StringMaxValidator{Max: 50},
+				validator.StringEmailValidator{},
+			})
+
+			// assert
+			Expect(descriptions).To(Equal([]validator.Description{
+				{Kind: "string.min", Params: map[string]any{"min": 3}, Summary: "at least 3 characters"},
+				{Kind: "string.max", Params: map[string]any{"max": 50}, Summary: "at most 50 characters"},
+				{Kind: "string.email", Summary: "must be an email"},
+			}))
+		})
+
+		It("should describe a rule without a Describe method as custom", func() {
+			// act
+			descriptions := validator.DescribeRules(validator.IntValidators{FakeTrueIntValidator{}})
+
+			// assert
+			Expect(descriptions).To(Equal([]validator.Description{{Kind: "custom"}}))
+		})
+
+		It("should describe the operands of a combinator", func() {
+			// act
+			descriptions := validator.DescribeRules(validator.StringValidators{
+				validator.Any[string](validator.StringMaxValidator{Max: 0}, validator.StringPhoneValidator{}),
+			})
+
+			// assert
+			Expect(descriptions).To(HaveLen(1))
+			Expect(descriptions[0].Kind).To(Equal("any"))
+			Expect(descriptions[0].Rules).To(HaveLen(2))
+			Expect(descriptions[0].Summary).To(Equal(
+				"at most 0 characters or must be an international phone number",
+			))
+		})
+	})
+
+	Describe("Summary", func() {
+		It("should join the summaries into a hint", func() {
+			// arrange
+			descriptions := validator.DescribeRules(validator.StringValidators{
+				validator.StringMinValidator{Min: 3},
+				validator.StringMaxValidator{Max: 50},
+				validator.StringEmailValidator{},
+			})
+
+			// act
+			summary := validator.Summary(descriptions)
+
+			// assert
+			Expect(summary).To(Equal("At least 3 characters, at most 50 characters, must be an email"))
+		})
+	})
+
+	Describe("Schema.Describe", func() {
+		It("should describe every field and object rule", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.StringField("email", validator.StringValidators{validator.StringEmailValidator{}}),
+					validator.IntField("age", validator.IntValidators{validator.IntMinValidator{Min: 18}}).Optional(),
+					validator.ObjectField("address", validator.Schema{
+						Fields: []validator.Field{
+							validator.StringField("city", validator.StringValidators{}),
+						},
+					}),
+				},
+				Rules: validator.ObjectValidators{
+					validator.AtLeastOneOfValidator{Fields: []string{"email", "age"}},
+				},
+			}
+
+			// act
+			description := schema.Describe()
+
+			// assert
+			Expect(description.Kind).To(Equal("object"))
+			Expect(description.Summary).To(Equal("at least one of email, age is required"))
+			Expect(description.Fields).To(HaveLen(3))
+			Expect(description.Fields[0].Name).To(Equal("email"))
+			Expect(description.Fields[0].Required).To(BeTrue())
+			Expect(description.Fields[0].Kind).To(Equal("string"))
+			Expect(description.Fields[0].Summary).To(Equal("must be an email"))
+			Expect(description.Fields[1].Required).To(BeFalse())
+			Expect(description.Fields[1].Rules[0].Kind).To(Equal("int.min"))
+			Expect(description.Fields[2].Kind).To(Equal("object"))
+			Expect(description.Fields[2].Fields[0].Name).To(Equal("city"))
+		})
+
+		It("should describe the presence rules of a field", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.StringField("account_type", validator.StringValidators{}),
+					validator.StringField("company_name", validator.StringValidators{}).Optional().With(
+						validator.WithEmptyAsMissing(),
+						validator.WithPresence(validator.PresenceValidators{
+							validator.RequiredIfValidator{Key: "account_type", Value: "business"},
+						}),
+					),
+				},
+			}
+
+			// act
+			description := schema.Describe()
+
+			// assert
+			Expect(description.Fields[0].Presence).To(BeEmpty())
+			Expect(description.Fields[1].Presence).To(Equal([]validator.Description{{
+				Kind:    "presence.required_if",
+				Params:  map[string]any{"key": "account_type", "value": "business"},
+				Summary: "required when account_type is business",
+			}}))
+		})
+
+		It("should encode to JSON", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.IntField("age", validator.IntValidators{validator.IntMaxValidator{Max: 99}}),
+				},
+			}
+
+			// act
+			data, err := json.Marshal(schema.Describe())
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).To(MatchJSON(`{
+				"kind": "object",
+				"fields": [{
+					"name": "age",
+					"required": true,
+					"kind": "int",
+					"summary": "at most 99",
+					"rules": [{"kind": "int.max", "params": {"max": 99}, "summary": "at most 99"}]
+				}]
+			}`))
+		})
+	})
+}
@@ -0,0 +1,470 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SyntaxError reports a rule string that cannot be parsed, Pos being the byte
+// offset of the faulty rule.
+type SyntaxError struct {
+	Rules string
+	Pos   int
+	Msg   string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at position %v in %q: %v", e.Pos, e.Rules, e.Msg)
+}
+
+// RuleSet is the parsed form of a rule string such as
+// "required|string|min:3|max:50|email". Only the rules of its Type are set.
+type RuleSet struct {
+	Type     string
+	Required bool
+
+	String   StringValidators
+	Int      IntValidators
+	Float    FloatValidators
+	Bool     BoolValidators
+	Time     TimeValidators
+	Duration DurationValidators
+	Date     DateValidators
+	UUID     UUIDValidators
+}
+
+var ruleTypes = []string{"string", "int", "float", "bool", "time", "duration", "date", "uuid"}
+
+// Validate validates value with the rules of the type of the set.
+func (s RuleSet) Validate(value any, opts ...Option) (any, error) {
+	return s.Field("").Validate(value, opts...)
+}
+
+// Field returns a schema field of the type and rules of the set, optional
+// unless the set is required.
+func (s RuleSet) Field(name string) Field {
+	var field Field
+	switch s.Type {
+	case "int":
+		field = IntField(name, s.Int)
+	case "float":
+		field = FloatField(name, s.Float)
+	case "bool":
+		field = BoolField(name, s.Bool)
+	case "time":
+		field = TimeField(name, s.Time)
+	case "duration":
+		field = DurationField(name, s.Duration)
+	case "date":
+		field = DateField(name, s.Date)
+	case "uuid":
+		field = UUIDField(name, s.UUID)
+	default:
+		field = StringField(name, s.String)
+	}
+	field.Required = s.Required
+	return field
+}
+
+func (s *RuleSet) add(rule any) error {
+	var ok bool
+	switch s.Type {
+	case "string":
+		var r stringValidator
+		if r, ok = rule.(stringValidator); ok {
+			s.String = append(s.String, r)
+		}
+	case "int":
+		var r intValidator
+		if r, ok = rule.(intValidator); ok {
+			s.Int = append(s.Int, r)
+		}
+	case "float":
+		var r floatValidator
+		if r, ok = rule.(floatValidator); ok {
+			s.Float = append(s.Float, r)
+		}
+	case "bool":
+		var r boolValidator
+		if r, ok = rule.(boolValidator); ok {
+			s.Bool = append(s.Bool, r)
+		}
+	case "time":
+		var r timeValidator
+		if r, ok = rule.(timeValidator); ok {
+			s.Time = append(s.Time, r)
+		}
+	case "duration":
+		var r durationValidator
+		if r, ok = rule.(durationValidator); ok {
+			s.Duration = append(s.Duration, r)
+		}
+	case "date":
+		var r dateValidator
+		if r, ok = rule.(dateValidator); ok {
+			s.Date = append(s.Date, r)
+		}
+	case "uuid":
+		var r uuidValidator
+		if r, ok = rule.(uuidValidator); ok {
+			s.UUID = append(s.UUID, r)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("rule is not a %v rule", s.Type)
+	}
+	return nil
+}
+
+// Registry holds the named rules a rule string may use, for each type, and
+// caches the rule strings it parsed. It is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	rules map[string]func(args []string) (any, error)
+	cache map[string]RuleSet
+}
+
+// NewRegistry returns a Registry holding the built-in rules.
+func NewRegistry() *Registry {
+	r := &Registry{
+		rules: map[string]func(args []string) (any, error){},
+		cache: map[string]RuleSet{},
+	}
+	registerBuiltinRules(r)
+	return r
+}
+
+// DefaultRegistry is the Registry used by ParseRules.
+var DefaultRegistry = NewRegistry()
+
+// RegisterRule names a rule of values of type T, which must be one of the
+// types of a RuleSet. factory builds the rule from the arguments given after
+// the colon of "name:a,b", and is called once per parsed rule string.
+func RegisterRule[T any](r *Registry, name string, factory func(args []string) (Rule[T], error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules[ruleTypeOf[T]()+"."+name] = func(args []string) (any, error) {
+		return factory(args)
+	}
+	r.cache = map[string]RuleSet{}
+}
+
+func ruleTypeOf[T any]() string {
+	var value T
+	switch any(value).(type) {
+	case string:
+		return "string"
+	case int:
+		return "int"
+	case float64:
+		return "float"
+	case bool:
+		return "bool"
+	case time.Time:
+		return "time"
+	case time.Duration:
+		return "duration"
+	case Date:
+		return "date"
+	case uuid.UUID:
+		return "uuid"
+	}
+	panic(fmt.Sprintf("validator: no rule string type for %T", value))
+}
+
+// ParseRules parses rules with DefaultRegistry.
+func ParseRules(rules string) (RuleSet, error) {
+	return DefaultRegistry.Parse(rules)
+}
+
+// Parse parses a rule string of rules separated by "|", each being a name
+// optionally followed by ":" and arguments separated by ",". "required" and
+// a type name are understood by every registry, the type defaulting to
+// string.
+func (r *Registry) Parse(rules string) (RuleSet, error) {
+	r.mu.RLock()
+	set, ok := r.cache[rules]
+	r.mu.RUnlock()
+	if ok {
+		return set, nil
+	}
+
+	set, err := r.parse(rules)
+	if err != nil {
+		return RuleSet{}, err
+	}
+
+	r.mu.Lock()
+	r.cache[rules] = set
+	r.mu.Unlock()
+	return set, nil
+}
+
+type ruleToken struct {
+	pos  int
+	name string
+	args []string
+}
+
+func (r *Registry) parse(rules string) (RuleSet, error) {
+	syntaxError := func(pos int, format string, args ...any) error {
+		return &SyntaxError{Rules: rules, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+	}
+
+	var tokens []ruleToken
+	pos := 0
+	for _, part := range strings.Split(rules, "|") {
+		token, err := parseRuleToken(part, pos)
+		if err != nil {
+			return RuleSet{}, syntaxError(pos, "%v", err)
+		}
+		tokens = append(tokens, token)
+		pos += len(part) + 1
+	}
+
+	set := RuleSet{Type: "string"}
+	typePos := -1
+	for _, token := range tokens {
+		if !isRuleType(token.name) {
+			continue
+		}
+		if typePos != -1 {
+			return RuleSet{}, syntaxError(token.pos, "type %q conflicts with type %q", token.name, set.Type)
+		}
+		if len(token.args) > 0 {
+			return RuleSet{}, syntaxError(token.pos, "type %q takes no argument", token.name)
+		}
+		set.Type = token.name
+		typePos = token.pos
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, token := range tokens {
+		switch {
+		case isRuleType(token.name):
+			continue
+		case token.name == "required":
+			if len(token.args) > 0 {
+				return RuleSet{}, syntaxError(token.pos, "rule \"required\" takes no argument")
+			}
+			set.Required = true
+			continue
+		}
+
+		factory, ok := r.rules[set.Type+"."+token.name]
+		if !ok {
+			return RuleSet{}, syntaxError(token.pos, "unknown %v rule %q", set.Type, token.name)
+		}
+		rule, err := factory(token.args)
+		if err != nil {
+			return RuleSet{}, syntaxError(token.pos, "rule %q: %v", token.name, err)
+		}
+		if err := set.add(rule); err != nil {
+			return RuleSet{}, syntaxError(token.pos, "rule %q: %v", token.name, err)
+		}
+	}
+
+	return set, nil
+}
+
+func parseRuleToken(part string, pos int) (ruleToken, error) {
+	name, rawArgs, hasArgs := strings.Cut(part, ":")
+	if name == "" {
+		return ruleToken{}, errors.New("empty rule")
+	}
+	for i, c := range name {
+		if !(c >= 'a' && c <= 'z' || c == '_' || i > 0 && c >= '0' && c <= '9') {
+			return ruleToken{}, fmt.Errorf("invalid rule name %q", name)
+		}
+	}
+
+	token := ruleToken{pos: pos, name: name}
+	if hasArgs {
+		if rawArgs == "" {
+			return ruleToken{}, fmt.Errorf("rule %q is missing its argument", name)
+		}
+		token.args = strings.Split(rawArgs, ",")
+	}
+	return token, nil
+}
+
+func isRuleType(name string) bool {
+	for _, ruleType := range ruleTypes {
+		if name == ruleType {
+			return true
+		}
+	}
+	return false
+}
+
+func ValidateMapRules(name string, value map[string]any, rules string, opts ...Option) (any, error) {
+	set, err := ParseRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if set.Required {
+			return nil, missingKeyError(name)
+		}
+		return nil, nil
+	}
+	return set.Validate(rawValue, opts...)
+}
+
+// ValidateRules validates value with a rule string parsed by ParseRules.
+func ValidateRules(value any, rules string, opts ...Option) (any, error) {
+	set, err := ParseRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	return set.Validate(value, opts...)
+}
+
+func registerBuiltinRules(r *Registry) {
+	RegisterRule(r, "min", intArgRule(func(n int) Rule[string] { return StringMinValidator{Min: n} }))
+	RegisterRule(r, "max", intArgRule(func(n int) Rule[string] { return StringMaxValidator{Max: n} }))
+	RegisterRule(r, "email", noArgRule[string](StringEmailValidator{}))
+	RegisterRule(r, "phone", noArgRule[string](StringPhoneValidator{}))
+
+	RegisterRule(r, "min", intArgRule(func(n int) Rule[int] { return IntMinValidator{Min: n} }))
+	RegisterRule(r, "max", intArgRule(func(n int) Rule[int] { return IntMaxValidator{Max: n} }))
+
+	RegisterRule(r, "min", floatArgRule(func(n float64) Rule[float64] { return FloatMinValidator{Min: n} }))
+	RegisterRule(r, "max", floatArgRule(func(n float64) Rule[float64] { return FloatMaxValidator{Max: n} }))
+
+	RegisterRule(r, "accepted", noArgRule[bool](BoolIsTrueValidator{}))
+	RegisterRule(r, "declined", noArgRule[bool](BoolIsFalseValidator{}))
+
+	RegisterRule(r, "min", timeArgRule(func(t time.Time) Rule[time.Time] { return TimeMinValidator{Min: t} }))
+	RegisterRule(r, "max", timeArgRule(func(t time.Time) Rule[time.Time] { return TimeMaxValidator{Max: t} }))
+	RegisterRule(r, "past", noArgRule[time.Time](TimePastValidator{}))
+	RegisterRule(r, "future", noArgRule[time.Time](TimeFutureValidator{}))
+
+	RegisterRule(r, "min", durationArgRule(func(d time.Duration) Rule[time.Duration] {
+		return DurationMinValidator{Min: d}
+	}))
+	RegisterRule(r, "max", durationArgRule(func(d time.Duration) Rule[time.Duration] {
+		return DurationMaxValidator{Max: d}
+	}))
+	RegisterRule(r, "multiple_of", durationArgRule(func(d time.Duration) Rule[time.Duration] {
+		return DurationMultipleOfValidator{Unit: d}
+	}))
+
+	RegisterRule(r, "min", dateArgRule(func(d Date) Rule[Date] { return DateMinValidator{Min: d} }))
+	RegisterRule(r, "max", dateArgRule(func(d Date) Rule[Date] { return DateMaxValidator{Max: d} }))
+
+	RegisterRule(r, "not_nil", noArgRule[uuid.UUID](UUIDNotNilValidator{}))
+	RegisterRule(r, "version", func(args []string) (Rule[uuid.UUID], error) {
+		versions := make([]uuid.Version, 0, len(args))
+		for _, arg := range args {
+			version, err := strconv.ParseUint(arg, 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a UUID version", arg)
+			}
+			versions = append(versions, uuid.Version(version))
+		}
+		if len(versions) == 0 {
+			return nil, errors.New("expected at least one version")
+		}
+		return UUIDVersionValidator{Versions: versions}, nil
+	})
+}
+
+func noArgRule[T any](rule Rule[T]) func(args []string) (Rule[T], error) {
+	return func(args []string) (Rule[T], error) {
+		if len(args) > 0 {
+			return nil, errors.New("expected no argument")
+		}
+		return rule, nil
+	}
+}
+
+func oneArg(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("expected 1 argument, got %v", len(args))
+	}
+	return args[0], nil
+}
+
+func intArgRule[T any](build func(n int) Rule[T]) func(args []string) (Rule[T], error) {
+	return func(args []string) (Rule[T], error) {
+		arg, err := oneArg(args)
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an int", arg)
+		}
+		return build(n), nil
+	}
+}
+
+func floatArgRule[T any](build func(n float64) Rule[T]) func(args []string) (Rule[T], error) {
+	return func(args []string) (Rule[T], error) {
+		arg, err := oneArg(args)
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", arg)
+		}
+		return build(n), nil
+	}
+}
+
+func timeArgRule[T any](build func(t time.Time) Rule[T]) func(args []string) (Rule[T], error) {
+	return func(args []string) (Rule[T], error) {
+		arg, err := oneArg(args)
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseTime(arg, newOptions(nil))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a time", arg)
+		}
+		return build(t), nil
+	}
+}
+
+func durationArgRule[T any](build func(d time.Duration) Rule[T]) func(args []string) (Rule[T], error) {
+	return func(args []string) (Rule[T], error) {
+		arg, err := oneArg(args)
+		if err != nil {
+			return nil, err
+		}
+		d, err := parseDuration(arg, newOptions(nil))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a duration", arg)
+		}
+		return build(d), nil
+	}
+}
+
+func dateArgRule[T any](build func(d Date) Rule[T]) func(args []string) (Rule[T], error) {
+	return func(args []string) (Rule[T], error) {
+		arg, err := oneArg(args)
+		if err != nil {
+			return nil, err
+		}
+		d, err := ParseDate(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a date", arg)
+		}
+		return build(d), nil
+	}
+}
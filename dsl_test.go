@@ -0,0 +1,159 @@
+package validator_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gungun974/validator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func dslTests() {
+	Describe("ParseRules", func() {
+		It("should parse a rule string into rule slices", func() {
+			// act
+			set, err := validator.ParseRules("required|string|min:3|max:50|email")
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(set.Type).To(Equal("string"))
+			Expect(set.Required).To(BeTrue())
+			Expect(set.String).To(Equal(validator.StringValidators{
+				validator.StringMinValidator{Min: 3},
+				validator.StringMaxValidator{Max: 50},
+				validator.StringEmailValidator{},
+			}))
+		})
+
+		It("should read the rules of the declared type", func() {
+			// act
+			set, err := validator.ParseRules("int|min:1|max:10")
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(set.Required).To(BeFalse())
+			Expect(set.Int).To(Equal(validator.IntValidators{
+				validator.IntMinValidator{Min: 1},
+				validator.IntMaxValidator{Max: 10},
+			}))
+		})
+
+		DescribeTable("should report syntax errors with their position",
+			func(rules string, pos int, message string) {
+				// act
+				_, err := validator.ParseRules(rules)
+
+				// assert
+				var syntaxErr *validator.SyntaxError
+				Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+				Expect(syntaxErr.Pos).To(Equal(pos))
+				Expect(syntaxErr.Msg).To(Equal(message))
+			},
+			Entry("an unknown rule", "required|string|emial", 16, "unknown string rule \"emial\""),
+			Entry("a rule of another type", "int|email", 4, "unknown int rule \"email\""),
+			Entry("an empty rule", "required||string", 9, "empty rule"),
+			Entry("an invalid name", "required|Min:3", 9, "invalid rule name \"Min\""),
+			Entry("a missing argument", "string|min:", 7, "rule \"min\" is missing its argument"),
+			Entry("a bad argument", "int|max:ten", 4, "rule \"max\": \"ten\" is not an int"),
+			Entry("two types", "int|string", 4, "type \"string\" conflicts with type \"int\""),
+		)
+
+		It("should format the syntax error", func() {
+			// act
+			_, err := validator.ParseRules("string|emial")
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal(
+				"syntax error at position 7 in \"string|emial\": unknown string rule \"emial\"",
+			))
+		})
+	})
+
+	Describe("Registry", func() {
+		It("should parse a registered rule", func() {
+			// arrange
+			registry := validator.NewRegistry()
+			validator.RegisterRule(registry, "prefix", func(args []string) (validator.Rule[string], error) {
+				return validator.StringFunc(func(value string) error {
+					if !strings.HasPrefix(value, args[0]) {
+						return errors.New("value must start with " + args[0])
+					}
+					return nil
+				}), nil
+			})
+			set, err := registry.Parse("string|prefix:sk_")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// act
+			_, err = set.Validate("pk_123")
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must start with sk_"))
+		})
+
+		It("should cache the parsed rule strings", func() {
+			// arrange
+			registry := validator.NewRegistry()
+			calls := 0
+			validator.RegisterRule(registry, "even", func(_ []string) (validator.Rule[int], error) {
+				calls++
+				return validator.IntFunc(func(value int) error { return nil }), nil
+			})
+
+			// act
+			_, _ = registry.Parse("int|even")
+			_, _ = registry.Parse("int|even")
+
+			// assert
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	Describe("ValidateMapRules", func() {
+		It("should validate the key with the rule string", func() {
+			// arrange
+			value := map[string]any{"age": 12}
+
+			// act
+			_, err := validator.ValidateMapRules("age", value, "required|int|min:18")
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be greater than 18"))
+		})
+
+		It("should report a missing required key", func() {
+			// act
+			_, err := validator.ValidateMapRules("email", map[string]any{}, "required|email")
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing key \"email\""))
+		})
+
+		It("should return nil for a missing optional key", func() {
+			// act
+			result, err := validator.ValidateMapRules("email", map[string]any{}, "email")
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("ValidateRules", func() {
+		It("should return the validated value", func() {
+			// act
+			result, err := validator.ValidateRules("90m", "duration|max:2h")
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(90 * time.Minute))
+		})
+	})
+}
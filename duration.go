@@ -0,0 +1,270 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DurationValidators []durationValidator
+
+type durationValidator interface {
+	Validate(value time.Duration) error
+}
+
+type DurationMaxValidator struct {
+	Max time.Duration
+}
+
+func (v DurationMaxValidator) Validate(value time.Duration) error {
+	if value > v.Max {
+		return fmt.Errorf("value must not be longer than %v", v.Max)
+	}
+	return nil
+}
+
+func (v DurationMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "duration.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("at most %v", v.Max),
+	}
+}
+
+type DurationMinValidator struct {
+	Min time.Duration
+}
+
+func (v DurationMinValidator) Validate(value time.Duration) error {
+	if value < v.Min {
+		return fmt.Errorf("value must not be shorter than %v", v.Min)
+	}
+	return nil
+}
+
+func (v DurationMinValidator) Describe() Description {
+	return Description{
+		Kind:    "duration.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("at least %v", v.Min),
+	}
+}
+
+type DurationMultipleOfValidator struct {
+	Unit time.Duration
+}
+
+func (v DurationMultipleOfValidator) Validate(value time.Duration) error {
+	if v.Unit != 0 && value%v.Unit != 0 {
+		return fmt.Errorf("value must be a multiple of %v", v.Unit)
+	}
+	return nil
+}
+
+func (v DurationMultipleOfValidator) Describe() Description {
+	return Description{
+		Kind:    "duration.multiple_of",
+		Params:  map[string]any{"unit": v.Unit},
+		Summary: fmt.Sprintf("a multiple of %v", v.Unit),
+	}
+}
+
+func ValidateMapDuration(
+	name string,
+	value map[string]any,
+	rules DurationValidators,
+	opts ...Option,
+) (time.Duration, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, missingKeyError(name)
+	}
+	return ValidateDuration(rawValue, rules, opts...)
+}
+
+func ValidateMapDurationOrNil(
+	name string,
+	value map[string]any,
+	rules DurationValidators,
+	opts ...Option,
+) (*time.Duration, error) {
+	return validateMapOrNil(name, value, ValidateDuration, rules, opts)
+}
+
+func ValidateMapDurationOrDefault(
+	name string,
+	value map[string]any,
+	rules DurationValidators,
+	defaultValue time.Duration,
+	opts ...Option,
+) (time.Duration, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateDuration, rules, func() time.Duration {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapDurationOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules DurationValidators,
+	defaultValue func() time.Duration,
+	opts ...Option,
+) (time.Duration, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateDuration, rules, defaultValue, opts)
+}
+
+// ValidateDuration accepts a time.Duration, a Go duration string such as "1h30m",
+// an ISO 8601 duration such as "PT1H30M" or a number of seconds.
+func ValidateDuration(value any, rules DurationValidators, opts ...Option) (time.Duration, error) {
+	o := newOptions(opts)
+
+	durationValue, err := parseDuration(value, o)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := applyRules(o.context(), rules, durationValue); err != nil {
+		return 0, err
+	}
+
+	return durationValue, nil
+}
+
+// durationMessage is a duration such as a *durationpb.Duration.
+type durationMessage interface {
+	AsDuration() time.Duration
+	IsValid() bool
+}
+
+func parseDuration(value any, o options) (time.Duration, error) {
+	switch value := value.(type) {
+	case time.Duration:
+		return value, nil
+	case durationMessage:
+		if value.IsValid() {
+			return value.AsDuration(), nil
+		}
+	case int:
+		if o.numberToDuration() {
+			return secondsDuration(float64(value))
+		}
+	case int64:
+		if o.numberToDuration() {
+			return secondsDuration(float64(value))
+		}
+	case float64:
+		if o.numberToDuration() {
+			return secondsDuration(value)
+		}
+	case string:
+		if !o.stringToTime() {
+			break
+		}
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration, nil
+		}
+		if duration, err := parseISO8601Duration(value); err == nil {
+			return duration, nil
+		}
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+			return secondsDuration(seconds)
+		}
+	}
+	return 0, typeError("value is not a duration")
+}
+
+func secondsDuration(seconds float64) (time.Duration, error) {
+	nanoseconds := math.Round(seconds * float64(time.Second))
+	if math.IsNaN(nanoseconds) || nanoseconds >= math.MaxInt64 || nanoseconds < math.MinInt64 {
+		return 0, typeError("value is not a duration")
+	}
+	return time.Duration(nanoseconds), nil
+}
+
+var errInvalidISO8601Duration = errors.New("invalid ISO 8601 duration")
+
+// parseISO8601Duration reads durations such as "P3DT4H5M6.5S". Years and
+// months have no fixed length, so only a zero amount of them is accepted.
+func parseISO8601Duration(value string) (time.Duration, error) {
+	negative := false
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		negative = value[0] == '-'
+		value = value[1:]
+	}
+
+	if !strings.HasPrefix(value, "P") || len(value) < 2 {
+		return 0, errInvalidISO8601Duration
+	}
+	value = value[1:]
+
+	// The designators of each part, in the order they must appear.
+	dateDesignators, dateUnits := "YMWD", []time.Duration{0, 0, 7 * 24 * time.Hour, 24 * time.Hour}
+	timeDesignators, timeUnits := "HMS", []time.Duration{time.Hour, time.Minute, time.Second}
+
+	var total time.Duration
+	designators, units := dateDesignators, dateUnits
+	next := 0
+	inTime := false
+	components := 0
+
+	for len(value) > 0 {
+		if value[0] == 'T' {
+			if inTime || len(value) == 1 {
+				return 0, errInvalidISO8601Duration
+			}
+			inTime = true
+			designators, units = timeDesignators, timeUnits
+			next = 0
+			value = value[1:]
+			continue
+		}
+
+		end := strings.IndexFunc(value, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if end <= 0 {
+			return 0, errInvalidISO8601Duration
+		}
+
+		number, err := strconv.ParseFloat(strings.Replace(value[:end], ",", ".", 1), 64)
+		if err != nil {
+			return 0, errInvalidISO8601Duration
+		}
+
+		index := strings.IndexByte(designators[next:], value[end])
+		if index < 0 {
+			return 0, errInvalidISO8601Duration
+		}
+		index += next
+		next = index + 1
+		unit := units[index]
+		if unit == 0 && number != 0 {
+			return 0, errInvalidISO8601Duration
+		}
+
+		amount := math.Round(number * float64(unit))
+		if amount >= float64(math.MaxInt64-total) {
+			return 0, errInvalidISO8601Duration
+		}
+
+		total += time.Duration(amount)
+		components++
+		value = value[end+1:]
+	}
+
+	if components == 0 {
+		return 0, errInvalidISO8601Duration
+	}
+
+	if negative {
+		total = -total
+	}
+
+	return total, nil
+}
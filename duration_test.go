@@ -0,0 +1,195 @@
+package validator_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/gungun974/validator"
+)
+
+type FakeTrueDurationValidator struct{}
+
+func (v FakeTrueDurationValidator) Validate(_ time.Duration) error {
+	return nil
+}
+
+type FakeErrorDurationValidator struct{}
+
+func (v FakeErrorDurationValidator) Validate(_ time.Duration) error {
+	return errors.New("this Duration validator always fail")
+}
+
+func durationValidatorTests() {
+	Describe("ValidateDuration", func() {
+		DescribeTable("should return a Duration",
+			func(value any, expected time.Duration) {
+				// act
+				result, err := validator.ValidateDuration(value, validator.DurationValidators{})
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(expected))
+			},
+			Entry("from a Duration", 15*time.Minute, 15*time.Minute),
+			Entry("from a Go duration string", "15m", 15*time.Minute),
+			Entry("from an ISO 8601 time duration", "PT1H30M", 90*time.Minute),
+			Entry("from an ISO 8601 date and time duration", "P1DT2H", 26*time.Hour),
+			Entry("from an ISO 8601 week duration", "P2W", 14*24*time.Hour),
+			Entry("from an ISO 8601 fractional duration", "PT1.5S", 1500*time.Millisecond),
+			Entry("from a negative ISO 8601 duration", "-PT10S", -10*time.Second),
+			Entry("from integer seconds", 90, 90*time.Second),
+			Entry("from float seconds", 1.5, 1500*time.Millisecond),
+			Entry("from a numeric string", "30", 30*time.Second),
+		)
+
+		DescribeTable("should not return a Duration when input is garbage",
+			func(value any) {
+				// act
+				_, err := validator.ValidateDuration(value, validator.DurationValidators{})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a duration"))
+			},
+			Entry("a bool", true),
+			Entry("a random string", "soon"),
+			Entry("an empty ISO 8601 duration", "P"),
+			Entry("an ISO 8601 duration with a dangling T", "P1DT"),
+			Entry("an ISO 8601 duration in months", "P1M"),
+			Entry("an ISO 8601 duration in years", "P1Y"),
+			Entry("an ISO 8601 duration with designators out of order", "PT1S1H"),
+			Entry("an ISO 8601 duration with a repeated designator", "PT1H1H"),
+			Entry("an ISO 8601 duration with dates out of order", "P1D1W"),
+			Entry("seconds overflowing by one nanosecond", 9223372036.854775808),
+			Entry("an ISO 8601 duration overflowing by one nanosecond", "PT9223372036.854775808S"),
+		)
+
+		It("should return a Duration when all rules are satisfied", func() {
+			// arrange
+			value := "1h"
+
+			// act
+			result, err := validator.ValidateDuration(value, validator.DurationValidators{
+				FakeTrueDurationValidator{},
+				FakeTrueDurationValidator{},
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(time.Hour))
+		})
+
+		It("should not return a Duration when one rule is not satisfied", func() {
+			// arrange
+			value := "1h"
+
+			// act
+			_, err := validator.ValidateDuration(value, validator.DurationValidators{
+				FakeTrueDurationValidator{},
+				FakeErrorDurationValidator{},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("this Duration validator always fail"))
+		})
+
+		It("should not return a Duration when it is longer than max", func() {
+			// arrange
+			value := "PT2H"
+
+			// act
+			_, err := validator.ValidateDuration(value, validator.DurationValidators{
+				validator.DurationMaxValidator{Max: time.Hour},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be longer than 1h0m0s"))
+		})
+
+		It("should not return a Duration when it is shorter than min", func() {
+			// arrange
+			value := "30s"
+
+			// act
+			_, err := validator.ValidateDuration(value, validator.DurationValidators{
+				validator.DurationMinValidator{Min: time.Minute},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be shorter than 1m0s"))
+		})
+
+		It("should not return a Duration when it is not a whole number of minutes", func() {
+			// arrange
+			value := "90s"
+
+			// act
+			_, err := validator.ValidateDuration(value, validator.DurationValidators{
+				validator.DurationMultipleOfValidator{Unit: time.Minute},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must be a multiple of 1m0s"))
+		})
+	})
+
+	Describe("ValidateDuration with protobuf", func() {
+		It("should return a Duration from a protobuf Duration", func() {
+			// arrange
+			value := durationpb.New(90 * time.Second)
+
+			// act
+			result, err := validator.ValidateDuration(value, validator.DurationValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(90 * time.Second))
+		})
+
+		It("should not return a Duration from an invalid protobuf Duration", func() {
+			// arrange
+			value := &durationpb.Duration{Seconds: 1, Nanos: -1}
+
+			// act
+			_, err := validator.ValidateDuration(value, validator.DurationValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a duration"))
+		})
+	})
+
+	Describe("ValidateMapDuration", func() {
+		It("should return a Duration from the map", func() {
+			// arrange
+			value := map[string]any{"timeout": "PT15M"}
+
+			// act
+			result, err := validator.ValidateMapDuration("timeout", value, validator.DurationValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(15 * time.Minute))
+		})
+
+		It("should not return a Duration when key is missing", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			_, err := validator.ValidateMapDuration("timeout", value, validator.DurationValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing key \"timeout\""))
+		})
+	})
+}
@@ -1,9 +1,7 @@
 package validator
 
 import (
-	"errors"
 	"fmt"
-	"strconv"
 )
 
 type FloatValidators []floatValidator
@@ -23,6 +21,14 @@ func (v FloatMaxValidator) Validate(value float64) error {
 	return nil
 }
 
+func (v FloatMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "float.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("at most %v", v.Max),
+	}
+}
+
 type FloatMinValidator struct {
 	Min float64
 }
@@ -34,29 +40,79 @@ func (v FloatMinValidator) Validate(value float64) error {
 	return nil
 }
 
-func ValidateMapFloat(name string, value map[string]any, rules FloatValidators) (float64, error) {
-	rawValue, ok := value[name]
+func (v FloatMinValidator) Describe() Description {
+	return Description{
+		Kind:    "float.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("at least %v", v.Min),
+	}
+}
+
+func ValidateMapFloat(
+	name string,
+	value map[string]any,
+	rules FloatValidators,
+	opts ...Option,
+) (float64, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return -1, err
+	}
 	if !ok {
-		return -1, fmt.Errorf("missing key \"%v\"", name)
+		return -1, missingKeyError(name)
 	}
-	return ValidateFloat(rawValue, rules)
+	return ValidateFloat(rawValue, rules, opts...)
+}
+
+func ValidateMapFloatOrNil(
+	name string,
+	value map[string]any,
+	rules FloatValidators,
+	opts ...Option,
+) (*float64, error) {
+	return validateMapOrNil(name, value, ValidateFloat, rules, opts)
 }
 
-func ValidateFloat(value any, rules FloatValidators) (float64, error) {
+func ValidateMapFloatOrDefault(
+	name string,
+	value map[string]any,
+	rules FloatValidators,
+	defaultValue float64,
+	opts ...Option,
+) (float64, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateFloat, rules, func() float64 {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapFloatOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules FloatValidators,
+	defaultValue func() float64,
+	opts ...Option,
+) (float64, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateFloat, rules, defaultValue, opts)
+}
+
+func ValidateFloat(value any, rules FloatValidators, opts ...Option) (float64, error) {
+	o := newOptions(opts)
+	if o.stringToNumber() {
+		value = parseNumberString(value)
+	}
+
 	intValue, intOk := value.(int)
 	floatValue, floatOk := value.(float64)
 	if !intOk && !floatOk {
-		return -1, errors.New("value is not a number")
+		return -1, typeError("value is not a number")
 	}
 
 	if intOk {
 		floatValue = float64(intValue)
 	}
 
-	for _, rule := range rules {
-		if err := rule.Validate(floatValue); err != nil {
-			return -1, err
-		}
+	if err := applyRules(o.context(), rules, floatValue); err != nil {
+		return -1, err
 	}
 
 	return floatValue, nil
@@ -66,22 +122,18 @@ func CoerceAndValidateMapFloat(
 	name string,
 	value map[string]any,
 	rules FloatValidators,
+	opts ...Option,
 ) (float64, error) {
-	rawValue, ok := value[name]
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return -1, err
+	}
 	if !ok {
-		return -1, fmt.Errorf("missing key \"%v\"", name)
+		return -1, missingKeyError(name)
 	}
-	return CoerceAndValidateFloat(rawValue, rules)
+	return CoerceAndValidateFloat(rawValue, rules, opts...)
 }
 
-func CoerceAndValidateFloat(value any, rules FloatValidators) (float64, error) {
-	stringValue, stringOk := value.(string)
-	if stringOk {
-		floatValue, err := strconv.ParseFloat(stringValue, 64)
-		if err == nil {
-			return ValidateFloat(floatValue, rules)
-		}
-	}
-
-	return ValidateFloat(value, rules)
+func CoerceAndValidateFloat(value any, rules FloatValidators, opts ...Option) (float64, error) {
+	return ValidateFloat(parseNumberString(value), rules, opts...)
 }
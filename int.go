@@ -1,9 +1,7 @@
 package validator
 
 import (
-	"errors"
 	"fmt"
-	"strconv"
 )
 
 type IntValidators []intValidator
@@ -23,6 +21,14 @@ func (v IntMaxValidator) Validate(value int) error {
 	return nil
 }
 
+func (v IntMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "int.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("at most %v", v.Max),
+	}
+}
+
 type IntMinValidator struct {
 	Min int
 }
@@ -34,58 +40,104 @@ func (v IntMinValidator) Validate(value int) error {
 	return nil
 }
 
-func ValidateMapInt(name string, value map[string]any, rules IntValidators) (int, error) {
-	rawValue, ok := value[name]
+func (v IntMinValidator) Describe() Description {
+	return Description{
+		Kind:    "int.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("at least %v", v.Min),
+	}
+}
+
+func ValidateMapInt(
+	name string,
+	value map[string]any,
+	rules IntValidators,
+	opts ...Option,
+) (int, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return -1, err
+	}
 	if !ok {
-		return -1, fmt.Errorf("missing key \"%v\"", name)
+		return -1, missingKeyError(name)
 	}
-	return ValidateInt(rawValue, rules)
+	return ValidateInt(rawValue, rules, opts...)
+}
+
+func ValidateMapIntOrNil(
+	name string,
+	value map[string]any,
+	rules IntValidators,
+	opts ...Option,
+) (*int, error) {
+	return validateMapOrNil(name, value, ValidateInt, rules, opts)
+}
+
+func ValidateMapIntOrDefault(
+	name string,
+	value map[string]any,
+	rules IntValidators,
+	defaultValue int,
+	opts ...Option,
+) (int, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateInt, rules, func() int {
+		return defaultValue
+	}, opts)
 }
 
-func ValidateInt(value any, rules IntValidators) (int, error) {
+func ValidateMapIntOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules IntValidators,
+	defaultValue func() int,
+	opts ...Option,
+) (int, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateInt, rules, defaultValue, opts)
+}
+
+func ValidateInt(value any, rules IntValidators, opts ...Option) (int, error) {
+	o := newOptions(opts)
+	if o.stringToNumber() {
+		value = parseNumberString(value)
+	}
+
 	intValue, intOk := value.(int)
 	floatValue, floatOk := value.(float64)
 	if !intOk && !floatOk {
-		return -1, errors.New("value is not a number")
+		return -1, typeError("value is not a number")
 	}
 
 	if floatOk {
 		if floatValue == float64(int(floatValue)) {
 			intValue = (int(floatValue))
 		} else {
-			return -1, errors.New("value is not an int")
+			return -1, typeError("value is not an int")
 		}
 	}
 
-	for _, rule := range rules {
-		if err := rule.Validate(intValue); err != nil {
-			return -1, err
-		}
+	if err := applyRules(o.context(), rules, intValue); err != nil {
+		return -1, err
 	}
 
 	return intValue, nil
 }
 
-func CoerceAndValidateMapInt(name string, value map[string]any, rules IntValidators) (int, error) {
-	rawValue, ok := value[name]
+func CoerceAndValidateMapInt(
+	name string,
+	value map[string]any,
+	rules IntValidators,
+	opts ...Option,
+) (int, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return -1, err
+	}
 	if !ok {
-		return -1, fmt.Errorf("missing key \"%v\"", name)
+		return -1, missingKeyError(name)
 	}
-	return CoerceAndValidateInt(rawValue, rules)
+	return CoerceAndValidateInt(rawValue, rules, opts...)
 }
 
-func CoerceAndValidateInt(value any, rules IntValidators) (int, error) {
-	stringValue, stringOk := value.(string)
-	if stringOk {
-		intValue, err := strconv.Atoi(stringValue)
-		if err == nil {
-			return ValidateInt(intValue, rules)
-		}
-		floatValue, err := strconv.ParseFloat(stringValue, 64)
-		if err == nil {
-			return ValidateInt(floatValue, rules)
-		}
-	}
-
-	return ValidateInt(value, rules)
+func CoerceAndValidateInt(value any, rules IntValidators, opts ...Option) (int, error) {
+	return ValidateInt(parseNumberString(value), rules, opts...)
 }
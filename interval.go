@@ -0,0 +1,333 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// Overlaps reports whether both intervals share an instant. Intervals that
+// only touch, one ending when the other starts, do not overlap.
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Start.Before(other.End) && other.Start.Before(i.End)
+}
+
+type IntervalValidators []intervalValidator
+
+type intervalValidator interface {
+	Validate(value Interval) error
+}
+
+type IntervalMaxDurationValidator struct {
+	Max time.Duration
+}
+
+func (v IntervalMaxDurationValidator) Validate(value Interval) error {
+	if value.Duration() > v.Max {
+		return fmt.Errorf("value must not last longer than %v", v.Max)
+	}
+	return nil
+}
+
+func (v IntervalMaxDurationValidator) Describe() Description {
+	return Description{
+		Kind:    "interval.max_duration",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("lasting at most %v", v.Max),
+	}
+}
+
+type IntervalMinDurationValidator struct {
+	Min time.Duration
+}
+
+func (v IntervalMinDurationValidator) Validate(value Interval) error {
+	if value.Duration() < v.Min {
+		return fmt.Errorf("value must not last shorter than %v", v.Min)
+	}
+	return nil
+}
+
+func (v IntervalMinDurationValidator) Describe() Description {
+	return Description{
+		Kind:    "interval.min_duration",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("lasting at least %v", v.Min),
+	}
+}
+
+// IntervalsConflictError lists the pairs of indices, lowest first, of the
+// intervals that break a rule of IntervalsValidators.
+type IntervalsConflictError struct {
+	Conflict string
+	Indices  [][2]int
+}
+
+func (e *IntervalsConflictError) Error() string {
+	messages := make([]string, 0, len(e.Indices))
+	for _, pair := range e.Indices {
+		messages = append(messages, fmt.Sprintf("intervals %v and %v %v", pair[0], pair[1], e.Conflict))
+	}
+	return strings.Join(messages, ", ")
+}
+
+type IntervalsValidators []intervalsValidator
+
+type intervalsValidator interface {
+	Validate(value []Interval) error
+}
+
+type IntervalsNoOverlapValidator struct{}
+
+func (v IntervalsNoOverlapValidator) Validate(value []Interval) error {
+	order := sortedIntervals(value)
+
+	var pairs [][2]int
+	for a, i := range order {
+		for _, j := range order[a+1:] {
+			if !value[j].Start.Before(value[i].End) {
+				break
+			}
+			pairs = append(pairs, intervalPair(i, j))
+		}
+	}
+
+	return intervalsConflict("overlap", pairs)
+}
+
+func (v IntervalsNoOverlapValidator) Describe() Description {
+	return Description{Kind: "intervals.no_overlap", Summary: "without overlaps"}
+}
+
+// IntervalsNoGapValidator rejects a time left uncovered between two intervals
+// for longer than MaxGap.
+type IntervalsNoGapValidator struct {
+	MaxGap time.Duration
+}
+
+func (v IntervalsNoGapValidator) Validate(value []Interval) error {
+	order := sortedIntervals(value)
+
+	if len(order) == 0 {
+		return nil
+	}
+
+	var pairs [][2]int
+	latest := order[0]
+	for _, next := range order[1:] {
+		if value[next].Start.Sub(value[latest].End) > v.MaxGap {
+			pairs = append(pairs, intervalPair(latest, next))
+		}
+		if value[next].End.After(value[latest].End) {
+			latest = next
+		}
+	}
+
+	return intervalsConflict("leave a gap", pairs)
+}
+
+func (v IntervalsNoGapValidator) Describe() Description {
+	return Description{
+		Kind:    "intervals.no_gap",
+		Params:  map[string]any{"max_gap": v.MaxGap},
+		Summary: fmt.Sprintf("with gaps of at most %v", v.MaxGap),
+	}
+}
+
+type IntervalsOrderedValidator struct{}
+
+func (v IntervalsOrderedValidator) Validate(value []Interval) error {
+	var pairs [][2]int
+	for i := 1; i < len(value); i++ {
+		if value[i].Start.Before(value[i-1].Start) {
+			pairs = append(pairs, [2]int{i - 1, i})
+		}
+	}
+
+	return intervalsConflict("are out of order", pairs)
+}
+
+func (v IntervalsOrderedValidator) Describe() Description {
+	return Description{Kind: "intervals.ordered", Summary: "in chronological order"}
+}
+
+func sortedIntervals(value []Interval) []int {
+	order := make([]int, len(value))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return value[order[a]].Start.Before(value[order[b]].Start)
+	})
+	return order
+}
+
+func intervalPair(i int, j int) [2]int {
+	if i > j {
+		return [2]int{j, i}
+	}
+	return [2]int{i, j}
+}
+
+func intervalsConflict(conflict string, pairs [][2]int) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+	sort.Slice(pairs, func(a, b int) bool {
+		if pairs[a][0] != pairs[b][0] {
+			return pairs[a][0] < pairs[b][0]
+		}
+		return pairs[a][1] < pairs[b][1]
+	})
+	return &IntervalsConflictError{Conflict: conflict, Indices: pairs}
+}
+
+func ValidateMapInterval(
+	name string,
+	value map[string]any,
+	rules IntervalValidators,
+	opts ...Option,
+) (Interval, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return Interval{}, err
+	}
+	if !ok {
+		return Interval{}, missingKeyError(name)
+	}
+	return ValidateInterval(rawValue, rules, opts...)
+}
+
+func ValidateMapIntervalOrNil(
+	name string,
+	value map[string]any,
+	rules IntervalValidators,
+	opts ...Option,
+) (*Interval, error) {
+	return validateMapOrNil(name, value, ValidateInterval, rules, opts)
+}
+
+func ValidateMapIntervalOrDefault(
+	name string,
+	value map[string]any,
+	rules IntervalValidators,
+	defaultValue Interval,
+	opts ...Option,
+) (Interval, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateInterval, rules, func() Interval {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapIntervalOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules IntervalValidators,
+	defaultValue func() Interval,
+	opts ...Option,
+) (Interval, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateInterval, rules, defaultValue, opts)
+}
+
+// ValidateInterval accepts an Interval or a map with a "start" and an "end"
+// key, both read by ValidateTime with opts.
+func ValidateInterval(value any, rules IntervalValidators, opts ...Option) (Interval, error) {
+	var intervalValue Interval
+
+	switch value := value.(type) {
+	case Interval:
+		intervalValue = value
+	case map[string]any:
+		opts := withoutPresence(opts)
+		start, err := ValidateMapTime("start", value, TimeValidators{}, opts...)
+		if err != nil {
+			return Interval{}, fmt.Errorf("start: %w", err)
+		}
+		end, err := ValidateMapTime("end", value, TimeValidators{}, opts...)
+		if err != nil {
+			return Interval{}, fmt.Errorf("end: %w", err)
+		}
+		intervalValue = Interval{Start: start, End: end}
+	default:
+		return Interval{}, typeError("value is not an interval")
+	}
+
+	if !intervalValue.Start.Before(intervalValue.End) {
+		return Interval{}, errors.New("value start must be before end")
+	}
+
+	if err := applyRules(newOptions(opts).context(), rules, intervalValue); err != nil {
+		return Interval{}, err
+	}
+
+	return intervalValue, nil
+}
+
+func ValidateMapIntervals(
+	name string,
+	value map[string]any,
+	itemRules IntervalValidators,
+	rules IntervalsValidators,
+	opts ...Option,
+) ([]Interval, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, missingKeyError(name)
+	}
+	return ValidateIntervals(rawValue, itemRules, rules, opts...)
+}
+
+// ValidateIntervals checks each interval with itemRules, then the whole
+// list with rules.
+func ValidateIntervals(
+	value any,
+	itemRules IntervalValidators,
+	rules IntervalsValidators,
+	opts ...Option,
+) ([]Interval, error) {
+	var items []any
+
+	switch value := value.(type) {
+	case []Interval:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	case []map[string]any:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	case []any:
+		items = value
+	default:
+		return nil, typeError("value is not a list of intervals")
+	}
+
+	intervals := make([]Interval, 0, len(items))
+	for i, item := range items {
+		interval, err := ValidateInterval(item, itemRules, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("interval %v: %w", i, err)
+		}
+		intervals = append(intervals, interval)
+	}
+
+	if err := applyRules(newOptions(opts).context(), rules, intervals); err != nil {
+		return nil, err
+	}
+
+	return intervals, nil
+}
@@ -0,0 +1,180 @@
+package validator_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func at(hour int, minute int) time.Time {
+	return time.Date(2024, 5, 3, hour, minute, 0, 0, time.UTC)
+}
+
+func intervalValidatorTests() {
+	Describe("ValidateInterval", func() {
+		It("should return an Interval from a start and end map", func() {
+			// arrange
+			value := map[string]any{
+				"start": "2024-05-03T09:00:00Z",
+				"end":   "2024-05-03T12:00:00Z",
+			}
+
+			// act
+			result, err := validator.ValidateInterval(value, validator.IntervalValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(validator.Interval{Start: at(9, 0), End: at(12, 0)}))
+		})
+
+		It("should not return an Interval when start is not before end", func() {
+			// arrange
+			value := validator.Interval{Start: at(12, 0), End: at(12, 0)}
+
+			// act
+			_, err := validator.ValidateInterval(value, validator.IntervalValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value start must be before end"))
+		})
+
+		It("should not return an Interval when end is missing", func() {
+			// arrange
+			value := map[string]any{"start": "2024-05-03T09:00:00Z"}
+
+			// act
+			_, err := validator.ValidateInterval(value, validator.IntervalValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("end: missing key \"end\""))
+		})
+
+		It("should not return an Interval when it lasts longer than max", func() {
+			// arrange
+			value := validator.Interval{Start: at(9, 0), End: at(12, 0)}
+
+			// act
+			_, err := validator.ValidateInterval(value, validator.IntervalValidators{
+				validator.IntervalMaxDurationValidator{Max: 2 * time.Hour},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not last longer than 2h0m0s"))
+		})
+
+		It("should not return an Interval when it lasts shorter than min", func() {
+			// arrange
+			value := validator.Interval{Start: at(9, 0), End: at(9, 10)}
+
+			// act
+			_, err := validator.ValidateInterval(value, validator.IntervalValidators{
+				validator.IntervalMinDurationValidator{Min: 15 * time.Minute},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not last shorter than 15m0s"))
+		})
+	})
+
+	Describe("ValidateIntervals", func() {
+		It("should return the Intervals when they do not conflict", func() {
+			// arrange
+			value := []any{
+				map[string]any{"start": "2024-05-03T09:00:00Z", "end": "2024-05-03T12:00:00Z"},
+				map[string]any{"start": "2024-05-03T12:00:00Z", "end": "2024-05-03T18:00:00Z"},
+			}
+
+			// act
+			result, err := validator.ValidateIntervals(value, validator.IntervalValidators{}, validator.IntervalsValidators{
+				validator.IntervalsNoOverlapValidator{},
+				validator.IntervalsNoGapValidator{},
+				validator.IntervalsOrderedValidator{},
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal([]validator.Interval{
+				{Start: at(9, 0), End: at(12, 0)},
+				{Start: at(12, 0), End: at(18, 0)},
+			}))
+		})
+
+		It("should report the index of an invalid Interval", func() {
+			// arrange
+			value := []validator.Interval{
+				{Start: at(9, 0), End: at(12, 0)},
+				{Start: at(14, 0), End: at(13, 0)},
+			}
+
+			// act
+			_, err := validator.ValidateIntervals(value, validator.IntervalValidators{}, validator.IntervalsValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("interval 1: value start must be before end"))
+		})
+
+		It("should report every pair of overlapping Intervals", func() {
+			// arrange
+			value := []validator.Interval{
+				{Start: at(9, 0), End: at(12, 0)},
+				{Start: at(14, 0), End: at(16, 0)},
+				{Start: at(11, 0), End: at(15, 0)},
+			}
+
+			// act
+			_, err := validator.ValidateIntervals(value, validator.IntervalValidators{}, validator.IntervalsValidators{
+				validator.IntervalsNoOverlapValidator{},
+			})
+
+			// assert
+			var conflict *validator.IntervalsConflictError
+			Expect(errors.As(err, &conflict)).To(BeTrue())
+			Expect(conflict.Indices).To(Equal([][2]int{{0, 2}, {1, 2}}))
+			Expect(err.Error()).To(Equal("intervals 0 and 2 overlap, intervals 1 and 2 overlap"))
+		})
+
+		It("should report a gap longer than the max gap", func() {
+			// arrange
+			value := []validator.Interval{
+				{Start: at(9, 0), End: at(12, 0)},
+				{Start: at(12, 30), End: at(14, 0)},
+				{Start: at(13, 0), End: at(18, 0)},
+			}
+
+			// act
+			_, err := validator.ValidateIntervals(value, validator.IntervalValidators{}, validator.IntervalsValidators{
+				validator.IntervalsNoGapValidator{MaxGap: 15 * time.Minute},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("intervals 0 and 1 leave a gap"))
+		})
+
+		It("should report Intervals out of order", func() {
+			// arrange
+			value := []validator.Interval{
+				{Start: at(12, 0), End: at(13, 0)},
+				{Start: at(9, 0), End: at(10, 0)},
+			}
+
+			// act
+			_, err := validator.ValidateIntervals(value, validator.IntervalValidators{}, validator.IntervalsValidators{
+				validator.IntervalsOrderedValidator{},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("intervals 0 and 1 are out of order"))
+		})
+	})
+}
@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+const ksuidAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const ksuidEpoch = 1400000000
+
+// KSUID is a 160 bits identifier made of a 32 bits timestamp, in seconds
+// since 2014-05-13T16:53:20Z, and a 128 bits random payload.
+type KSUID [20]byte
+
+func ParseKSUID(value string) (KSUID, error) {
+	var ksuid KSUID
+	if len(value) != 27 {
+		return ksuid, errors.New("invalid KSUID length")
+	}
+	if !decodeBase(value, ksuidAlphabet, ksuid[:]) {
+		return ksuid, errors.New("invalid KSUID character or overflow")
+	}
+	return ksuid, nil
+}
+
+func (k KSUID) Time() time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint32(k[:4]))+ksuidEpoch, 0).UTC()
+}
+
+func (k KSUID) Payload() []byte {
+	return k[4:]
+}
+
+func (k KSUID) String() string {
+	return encodeBase(k[:], ksuidAlphabet, 27)
+}
+
+func ValidateMapKSUID(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	opts ...Option,
+) (KSUID, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return KSUID{}, err
+	}
+	if !ok {
+		return KSUID{}, missingKeyError(name)
+	}
+	return ValidateKSUID(rawValue, rules, opts...)
+}
+
+func ValidateMapKSUIDOrNil(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	opts ...Option,
+) (*KSUID, error) {
+	return validateMapOrNil(name, value, ValidateKSUID, rules, opts)
+}
+
+func ValidateMapKSUIDOrDefault(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue KSUID,
+	opts ...Option,
+) (KSUID, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateKSUID, rules, func() KSUID {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapKSUIDOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue func() KSUID,
+	opts ...Option,
+) (KSUID, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateKSUID, rules, defaultValue, opts)
+}
+
+// ValidateKSUID checks the alphabet and length of a KSUID, then its creation
+// time with rules. A creation time past WithTimestampSkew is always rejected.
+func ValidateKSUID(value any, rules TimeValidators, opts ...Option) (KSUID, error) {
+	ksuidValue, ksuidOk := value.(KSUID)
+
+	if !ksuidOk {
+		stringValue, stringOk := value.(string)
+		if !stringOk {
+			return KSUID{}, typeError("value is not a KSUID")
+		}
+
+		var err error
+		ksuidValue, err = ParseKSUID(stringValue)
+		if err != nil {
+			return KSUID{}, typeError("value is not a KSUID")
+		}
+	}
+
+	if _, err := ValidateTime(ksuidValue.Time(), rules, opts...); err != nil {
+		return KSUID{}, err
+	}
+
+	if err := checkTimestamp(ksuidValue.Time(), time.Unix(ksuidEpoch, 0), newOptions(opts)); err != nil {
+		return KSUID{}, err
+	}
+
+	return ksuidValue, nil
+}
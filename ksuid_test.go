@@ -0,0 +1,69 @@
+package validator_test
+
+import (
+	"encoding/hex"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func ksuidValidatorTests() {
+	Describe("ValidateKSUID", func() {
+		It("should return a KSUID with its creation time and payload", func() {
+			// arrange
+			value := "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+
+			// act
+			result, err := validator.ValidateKSUID(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.String()).To(Equal("0ujtsYcgvSTl8PAuAdqWYSMnLOv"))
+			Expect(result.Time()).To(Equal(time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)))
+			Expect(hex.EncodeToString(result.Payload())).To(Equal("b5a1cd34b5f99d1154fb6853345c9735"))
+		})
+
+		DescribeTable("should not return a KSUID when input is garbage",
+			func(value any) {
+				// act
+				_, err := validator.ValidateKSUID(value, validator.TimeValidators{})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a KSUID"))
+			},
+			Entry("an int", 42),
+			Entry("a short string", "0ujtsYcgvSTl8PAuAdqWYSMnLO"),
+			Entry("a forbidden character", "0ujtsYcgvSTl8PAuAdqWYSMnLO-"),
+			Entry("an overflowing value", "zzzzzzzzzzzzzzzzzzzzzzzzzzz"),
+		)
+
+		It("should not return a KSUID created before min", func() {
+			// arrange
+			value := "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+
+			// act
+			_, err := validator.ValidateKSUID(value, validator.TimeValidators{
+				validator.TimeMinValidator{Min: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should not return a KSUID with an implausible creation time", func() {
+			// arrange
+			value := "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+			// act
+			_, err := validator.ValidateKSUID(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value has an implausible timestamp"))
+		})
+	})
+}
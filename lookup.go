@@ -0,0 +1,196 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LookupError reports that a rule could not reach its Lookup, as opposed to
+// the value being invalid. Schema returns it as is instead of collecting it
+// with the field errors.
+type LookupError struct {
+	Err error
+}
+
+func (e *LookupError) Error() string {
+	return fmt.Sprintf("lookup failed: %v", e.Err)
+}
+
+func (e *LookupError) Unwrap() error {
+	return e.Err
+}
+
+// IsLookupError reports whether err is or wraps a LookupError.
+func IsLookupError(err error) bool {
+	var lookupErr *LookupError
+	return errors.As(err, &lookupErr)
+}
+
+// Lookup tells whether a key exists in an external store such as a database.
+type Lookup interface {
+	Exists(ctx context.Context, key string) (bool, error)
+}
+
+type LookupFunc func(ctx context.Context, key string) (bool, error)
+
+func (f LookupFunc) Exists(ctx context.Context, key string) (bool, error) {
+	return f(ctx, key)
+}
+
+// MemoryLookup is an in-memory Lookup safe for concurrent use, meant for tests.
+type MemoryLookup struct {
+	mu   sync.RWMutex
+	keys map[string]struct{}
+}
+
+func NewMemoryLookup(keys ...string) *MemoryLookup {
+	l := &MemoryLookup{keys: make(map[string]struct{}, len(keys))}
+	for _, key := range keys {
+		l.keys[key] = struct{}{}
+	}
+	return l
+}
+
+func (l *MemoryLookup) Add(keys ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		l.keys[key] = struct{}{}
+	}
+}
+
+func (l *MemoryLookup) Remove(keys ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		delete(l.keys, key)
+	}
+}
+
+func (l *MemoryLookup) Exists(ctx context.Context, key string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, ok := l.keys[key]
+	return ok, nil
+}
+
+func lookupExists(ctx context.Context, lookup Lookup, timeout time.Duration, key string) (bool, error) {
+	if lookup == nil {
+		return false, &LookupError{Err: errors.New("no lookup configured")}
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	exists, err := lookup.Exists(ctx, key)
+	if err != nil {
+		return false, &LookupError{Err: err}
+	}
+	return exists, nil
+}
+
+type StringExistsValidator struct {
+	Lookup  Lookup
+	Timeout time.Duration
+}
+
+func (v StringExistsValidator) Validate(value string) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v StringExistsValidator) Describe() Description {
+	return Description{Kind: "string.exists", Summary: "must exist"}
+}
+
+func (v StringExistsValidator) ValidateContext(ctx context.Context, value string) error {
+	exists, err := lookupExists(ctx, v.Lookup, v.Timeout, value)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return &CodeError{Code: CodeNotFound, Err: errors.New("value does not exist")}
+	}
+	return nil
+}
+
+type StringUniqueValidator struct {
+	Lookup  Lookup
+	Timeout time.Duration
+}
+
+func (v StringUniqueValidator) Validate(value string) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v StringUniqueValidator) Describe() Description {
+	return Description{Kind: "string.unique", Summary: "must not be taken"}
+}
+
+func (v StringUniqueValidator) ValidateContext(ctx context.Context, value string) error {
+	exists, err := lookupExists(ctx, v.Lookup, v.Timeout, value)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return &CodeError{Code: CodeTaken, Err: errors.New("value is already taken")}
+	}
+	return nil
+}
+
+type IntExistsValidator struct {
+	Lookup  Lookup
+	Timeout time.Duration
+}
+
+func (v IntExistsValidator) Validate(value int) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v IntExistsValidator) Describe() Description {
+	return Description{Kind: "int.exists", Summary: "must exist"}
+}
+
+func (v IntExistsValidator) ValidateContext(ctx context.Context, value int) error {
+	exists, err := lookupExists(ctx, v.Lookup, v.Timeout, strconv.Itoa(value))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return &CodeError{Code: CodeNotFound, Err: errors.New("value does not exist")}
+	}
+	return nil
+}
+
+type UUIDExistsValidator struct {
+	Lookup  Lookup
+	Timeout time.Duration
+}
+
+func (v UUIDExistsValidator) Validate(value uuid.UUID) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v UUIDExistsValidator) Describe() Description {
+	return Description{Kind: "uuid.exists", Summary: "must exist"}
+}
+
+func (v UUIDExistsValidator) ValidateContext(ctx context.Context, value uuid.UUID) error {
+	exists, err := lookupExists(ctx, v.Lookup, v.Timeout, value.String())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return &CodeError{Code: CodeNotFound, Err: errors.New("value does not exist")}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gungun974/validator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type contextKey struct{}
+
+type FakeContextStringValidator struct{}
+
+func (v FakeContextStringValidator) Validate(_ string) error {
+	return errors.New("this String validator needs a context")
+}
+
+func (v FakeContextStringValidator) ValidateContext(ctx context.Context, value string) error {
+	if ctx.Value(contextKey{}) != value {
+		return errors.New("value does not match the context")
+	}
+	return nil
+}
+
+func lookupValidatorTests() {
+	Describe("WithContext", func() {
+		It("should hand the context to context-aware rules", func() {
+			// arrange
+			ctx := context.WithValue(context.Background(), contextKey{}, "tenant")
+
+			// act
+			result, err := validator.ValidateString(
+				"tenant",
+				validator.StringValidators{FakeContextStringValidator{}},
+				validator.WithContext(ctx),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal("tenant"))
+		})
+
+		It("should stop with a lookup error when the context is cancelled", func() {
+			// arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// act
+			_, err := validator.ValidateInt(
+				4,
+				validator.IntValidators{FakeTrueIntValidator{}},
+				validator.WithContext(ctx),
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(validator.IsLookupError(err)).To(BeTrue())
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		})
+	})
+
+	Describe("StringUniqueValidator", func() {
+		It("should not return a String when the value is taken", func() {
+			// arrange
+			lookup := validator.NewMemoryLookup("john@doe.com")
+
+			// act
+			_, err := validator.ValidateString("john@doe.com", validator.StringValidators{
+				validator.StringUniqueValidator{Lookup: lookup},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is already taken"))
+			Expect(validator.IsLookupError(err)).To(BeFalse())
+		})
+
+		It("should return a String when the value is free", func() {
+			// arrange
+			lookup := validator.NewMemoryLookup("john@doe.com")
+			lookup.Remove("john@doe.com")
+
+			// act
+			result, err := validator.ValidateString("john@doe.com", validator.StringValidators{
+				validator.StringUniqueValidator{Lookup: lookup},
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal("john@doe.com"))
+		})
+	})
+
+	Describe("StringExistsValidator", func() {
+		It("should report a failing lookup apart from an invalid value", func() {
+			// arrange
+			lookup := validator.LookupFunc(func(_ context.Context, _ string) (bool, error) {
+				return false, errors.New("connection refused")
+			})
+
+			// act
+			_, err := validator.ValidateString("books", validator.StringValidators{
+				validator.StringExistsValidator{Lookup: lookup},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("lookup failed: connection refused"))
+			Expect(validator.IsLookupError(err)).To(BeTrue())
+		})
+
+		It("should give up on a slow lookup after its timeout", func() {
+			// arrange
+			lookup := validator.LookupFunc(func(ctx context.Context, _ string) (bool, error) {
+				<-ctx.Done()
+				return false, ctx.Err()
+			})
+
+			// act
+			_, err := validator.ValidateString("books", validator.StringValidators{
+				validator.StringExistsValidator{Lookup: lookup, Timeout: time.Millisecond},
+			})
+
+			// assert
+			Expect(validator.IsLookupError(err)).To(BeTrue())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
+
+	Describe("IntExistsValidator", func() {
+		It("should not return an Int when the value does not exist", func() {
+			// arrange
+			lookup := validator.NewMemoryLookup("1", "2")
+
+			// act
+			_, err := validator.ValidateInt(3, validator.IntValidators{
+				validator.IntExistsValidator{Lookup: lookup},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value does not exist"))
+		})
+	})
+
+	Describe("UUIDExistsValidator", func() {
+		It("should return a UUID when the value exists", func() {
+			// arrange
+			id := "c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2"
+			lookup := validator.NewMemoryLookup(id)
+
+			// act
+			result, err := validator.ValidateUUIDWith(id, validator.UUIDValidators{
+				validator.UUIDExistsValidator{Lookup: lookup},
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(uuid.MustParse(id)))
+		})
+	})
+
+	Describe("Schema.ValidateContext", func() {
+		It("should return a lookup error instead of field errors", func() {
+			// arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.IntField("age", validator.IntValidators{validator.IntMaxValidator{Max: 10}}),
+					validator.StringField("email", validator.StringValidators{
+						validator.StringUniqueValidator{Lookup: validator.NewMemoryLookup()},
+					}),
+				},
+			}
+
+			// act
+			_, err := schema.ValidateContext(ctx, map[string]any{"age": 4, "email": "john@doe.com"})
+
+			// assert
+			var lookupErr *validator.LookupError
+			Expect(errors.As(err, &lookupErr)).To(BeTrue())
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		})
+	})
+}
@@ -0,0 +1,84 @@
+package validator
+
+import (
+	"strings"
+)
+
+const NanoIDAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// WithNanoID sets the length and alphabet of NanoIDs, 21 characters of
+// NanoIDAlphabet by default.
+func WithNanoID(size int, alphabet string) Option {
+	return func(o *options) {
+		o.nanoIDSize = size
+		o.nanoIDAlphabet = alphabet
+	}
+}
+
+func ValidateMapNanoID(
+	name string,
+	value map[string]any,
+	rules StringValidators,
+	opts ...Option,
+) (string, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", missingKeyError(name)
+	}
+	return ValidateNanoID(rawValue, rules, opts...)
+}
+
+func ValidateMapNanoIDOrNil(
+	name string,
+	value map[string]any,
+	rules StringValidators,
+	opts ...Option,
+) (*string, error) {
+	return validateMapOrNil(name, value, ValidateNanoID, rules, opts)
+}
+
+func ValidateMapNanoIDOrDefault(
+	name string,
+	value map[string]any,
+	rules StringValidators,
+	defaultValue string,
+	opts ...Option,
+) (string, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateNanoID, rules, func() string {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapNanoIDOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules StringValidators,
+	defaultValue func() string,
+	opts ...Option,
+) (string, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateNanoID, rules, defaultValue, opts)
+}
+
+func ValidateNanoID(value any, rules StringValidators, opts ...Option) (string, error) {
+	o := newOptions(opts)
+
+	stringValue, stringOk := value.(string)
+	if !stringOk || len(stringValue) != o.nanoIDSize {
+		return "", typeError("value is not a NanoID")
+	}
+
+	for _, c := range stringValue {
+		if !strings.ContainsRune(o.nanoIDAlphabet, c) {
+			return "", typeError("value is not a NanoID")
+		}
+	}
+
+	if err := applyRules(o.context(), rules, stringValue); err != nil {
+		return "", err
+	}
+
+	return stringValue, nil
+}
@@ -0,0 +1,54 @@
+package validator_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func nanoIDValidatorTests() {
+	Describe("ValidateNanoID", func() {
+		It("should return a NanoID", func() {
+			// arrange
+			value := "V1StGXR8_Z5jdHi6B-myT"
+
+			// act
+			result, err := validator.ValidateNanoID(value, validator.StringValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal("V1StGXR8_Z5jdHi6B-myT"))
+		})
+
+		DescribeTable("should not return a NanoID when input is garbage",
+			func(value any) {
+				// act
+				_, err := validator.ValidateNanoID(value, validator.StringValidators{})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a NanoID"))
+			},
+			Entry("an int", 42),
+			Entry("a short string", "V1StGXR8_Z5jdHi6B-my"),
+			Entry("a forbidden character", "V1StGXR8_Z5jdHi6B-my!"),
+		)
+
+		It("should return a NanoID with a custom size and alphabet", func() {
+			// arrange
+			value := "4f90d13a42"
+
+			// act
+			result, err := validator.ValidateNanoID(
+				value,
+				validator.StringValidators{},
+				validator.WithNanoID(10, "0123456789abcdef"),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal("4f90d13a42"))
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package validator
+
+type keyState int
+
+const (
+	keyMissing keyState = iota
+	keyNull
+	keyPresent
+)
+
+// WithRejectNull refuses an explicit null instead of reading it as a missing
+// key.
+func WithRejectNull() Option {
+	return func(o *options) {
+		o.rejectNull = true
+	}
+}
+
+// WithEmptyAsMissing reads an empty string, as sent by an empty form input, as
+// a missing key.
+func WithEmptyAsMissing() Option {
+	return func(o *options) {
+		o.emptyAsMissing = true
+	}
+}
+
+// Optional tells apart a missing key, an explicit null and a value, as a
+// PATCH payload means "leave unchanged" by the first and "clear" by the second.
+type Optional[T any] struct {
+	Value   T
+	Present bool
+	Null    bool
+}
+
+// Nullable is a value that may be an explicit null, in which case Valid is
+// false.
+type Nullable[T any] struct {
+	Value T
+	Valid bool
+}
+
+func (n Nullable[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Value
+}
+
+// ValidateMapOptional validates the key with validate and rules unless it is
+// missing or null, e.g. ValidateMapOptional("name", value, ValidateString,
+// StringValidators{}).
+func ValidateMapOptional[T, R any](
+	name string,
+	value map[string]any,
+	validate func(value any, rules R, opts ...Option) (T, error),
+	rules R,
+	opts ...Option,
+) (Optional[T], error) {
+	rawValue, state, err := lookupState(name, value, opts)
+	if err != nil {
+		return Optional[T]{}, err
+	}
+
+	switch state {
+	case keyMissing:
+		return Optional[T]{}, nil
+	case keyNull:
+		return Optional[T]{Present: true, Null: true}, nil
+	}
+
+	val, err := validate(rawValue, rules, opts...)
+	if err != nil {
+		return Optional[T]{}, err
+	}
+	return Optional[T]{Value: val, Present: true}, nil
+}
+
+// ValidateMapNullable requires the key but accepts an explicit null, even with
+// WithRejectNull.
+func ValidateMapNullable[T, R any](
+	name string,
+	value map[string]any,
+	validate func(value any, rules R, opts ...Option) (T, error),
+	rules R,
+	opts ...Option,
+) (Nullable[T], error) {
+	rawValue, state, err := lookupState(name, value, append(opts[:len(opts):len(opts)], func(o *options) {
+		o.rejectNull = false
+	}))
+	if err != nil {
+		return Nullable[T]{}, err
+	}
+
+	switch state {
+	case keyMissing:
+		return Nullable[T]{}, missingKeyError(name)
+	case keyNull:
+		return Nullable[T]{}, nil
+	}
+
+	val, err := validate(rawValue, rules, opts...)
+	if err != nil {
+		return Nullable[T]{}, err
+	}
+	return Nullable[T]{Value: val, Valid: true}, nil
+}
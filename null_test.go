@@ -0,0 +1,195 @@
+package validator_test
+
+import (
+	"github.com/gungun974/validator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func nullTests() {
+	Describe("ValidateMapStringOrNil", func() {
+		It("should read an explicit null as a missing key", func() {
+			// arrange
+			value := map[string]any{"name": nil}
+
+			// act
+			result, err := validator.ValidateMapStringOrNil("name", value, validator.StringValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should refuse an explicit null with WithRejectNull", func() {
+			// arrange
+			value := map[string]any{"name": nil}
+
+			// act
+			_, err := validator.ValidateMapStringOrNil(
+				"name",
+				value,
+				validator.StringValidators{},
+				validator.WithRejectNull(),
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("key \"name\" must not be null"))
+		})
+
+		It("should read an empty string as a missing key with WithEmptyAsMissing", func() {
+			// arrange
+			value := map[string]any{"name": ""}
+
+			// act
+			result, err := validator.ValidateMapStringOrNil(
+				"name",
+				value,
+				validator.StringValidators{},
+				validator.WithEmptyAsMissing(),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should keep an empty string by default", func() {
+			// arrange
+			value := map[string]any{"name": ""}
+
+			// act
+			result, err := validator.ValidateMapStringOrNil("name", value, validator.StringValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(*result).To(Equal(""))
+		})
+	})
+
+	Describe("ValidateMapString", func() {
+		It("should report an explicit null as a missing key", func() {
+			// arrange
+			value := map[string]any{"name": nil}
+
+			// act
+			_, err := validator.ValidateMapString("name", value, validator.StringValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing key \"name\""))
+		})
+	})
+
+	DescribeTable("ValidateMapOptional",
+		func(value map[string]any, expected validator.Optional[int]) {
+			// act
+			result, err := validator.ValidateMapOptional(
+				"age",
+				value,
+				validator.ValidateInt,
+				validator.IntValidators{},
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("a missing key", map[string]any{}, validator.Optional[int]{}),
+		Entry("an explicit null", map[string]any{"age": nil}, validator.Optional[int]{Present: true, Null: true}),
+		Entry("a value", map[string]any{"age": 42}, validator.Optional[int]{Value: 42, Present: true}),
+	)
+
+	Describe("ValidateMapOptional", func() {
+		It("should validate a present value", func() {
+			// arrange
+			value := map[string]any{"age": 42}
+
+			// act
+			_, err := validator.ValidateMapOptional(
+				"age",
+				value,
+				validator.ValidateInt,
+				validator.IntValidators{validator.IntMaxValidator{Max: 30}},
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be greater than 30"))
+		})
+	})
+
+	Describe("ValidateMapNullable", func() {
+		It("should accept an explicit null even with WithRejectNull", func() {
+			// arrange
+			value := map[string]any{"nickname": nil}
+
+			// act
+			result, err := validator.ValidateMapNullable(
+				"nickname",
+				value,
+				validator.ValidateString,
+				validator.StringValidators{},
+				validator.WithRejectNull(),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Ptr()).To(BeNil())
+		})
+
+		It("should return a valid value", func() {
+			// arrange
+			value := map[string]any{"nickname": "gungun"}
+
+			// act
+			result, err := validator.ValidateMapNullable(
+				"nickname",
+				value,
+				validator.ValidateString,
+				validator.StringValidators{},
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(validator.Nullable[string]{Value: "gungun", Valid: true}))
+		})
+
+		It("should require the key", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			_, err := validator.ValidateMapNullable(
+				"nickname",
+				value,
+				validator.ValidateString,
+				validator.StringValidators{},
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing key \"nickname\""))
+		})
+	})
+
+	Describe("Schema", func() {
+		It("should skip an optional field set to null", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.StringField("bio", validator.StringValidators{}).Optional(),
+				},
+			}
+
+			// act
+			result, err := schema.Validate(map[string]any{"bio": nil})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeEmpty())
+		})
+	})
+}
@@ -0,0 +1,273 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type ObjectValidators []objectValidator
+
+// objectValidator receives the validated values of a Schema, keyed by field
+// name. Returning a FieldError attaches the error to that field.
+type objectValidator interface {
+	Validate(value map[string]any) error
+}
+
+type EqualFieldValidator struct {
+	Field string
+	Other string
+}
+
+func (v EqualFieldValidator) Validate(value map[string]any) error {
+	a, aOk := value[v.Field]
+	b, bOk := value[v.Other]
+	if !aOk || !bOk {
+		return nil
+	}
+
+	if order, ok := compareValues(a, b); ok && order == 0 || !ok && reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return &FieldError{Path: []string{v.Field}, Err: fmt.Errorf("value must be equal to %v", v.Other)}
+}
+
+func (v EqualFieldValidator) Describe() Description {
+	return Description{
+		Kind:    "object.equal_field",
+		Params:  map[string]any{"field": v.Field, "other": v.Other},
+		Summary: fmt.Sprintf("%v must be equal to %v", v.Field, v.Other),
+	}
+}
+
+type LessThanFieldValidator struct {
+	Field   string
+	Other   string
+	OrEqual bool
+}
+
+func (v LessThanFieldValidator) Validate(value map[string]any) error {
+	order, ok := compareFields(value, v.Field, v.Other)
+	if !ok || order < 0 || v.OrEqual && order == 0 {
+		return nil
+	}
+	if v.OrEqual {
+		return &FieldError{Path: []string{v.Field}, Err: fmt.Errorf("value must not be greater than %v", v.Other)}
+	}
+	return &FieldError{Path: []string{v.Field}, Err: fmt.Errorf("value must be less than %v", v.Other)}
+}
+
+func (v LessThanFieldValidator) Describe() Description {
+	summary := fmt.Sprintf("%v must be less than %v", v.Field, v.Other)
+	if v.OrEqual {
+		summary = fmt.Sprintf("%v must not be greater than %v", v.Field, v.Other)
+	}
+	return Description{
+		Kind:    "object.less_than_field",
+		Params:  map[string]any{"field": v.Field, "other": v.Other, "or_equal": v.OrEqual},
+		Summary: summary,
+	}
+}
+
+type GreaterThanFieldValidator struct {
+	Field   string
+	Other   string
+	OrEqual bool
+}
+
+func (v GreaterThanFieldValidator) Validate(value map[string]any) error {
+	order, ok := compareFields(value, v.Field, v.Other)
+	if !ok || order > 0 || v.OrEqual && order == 0 {
+		return nil
+	}
+	if v.OrEqual {
+		return &FieldError{Path: []string{v.Field}, Err: fmt.Errorf("value must not be less than %v", v.Other)}
+	}
+	return &FieldError{Path: []string{v.Field}, Err: fmt.Errorf("value must be greater than %v", v.Other)}
+}
+
+func (v GreaterThanFieldValidator) Describe() Description {
+	summary := fmt.Sprintf("%v must be greater than %v", v.Field, v.Other)
+	if v.OrEqual {
+		summary = fmt.Sprintf("%v must not be less than %v", v.Field, v.Other)
+	}
+	return Description{
+		Kind:    "object.greater_than_field",
+		Params:  map[string]any{"field": v.Field, "other": v.Other, "or_equal": v.OrEqual},
+		Summary: summary,
+	}
+}
+
+type AtLeastOneOfValidator struct {
+	Fields []string
+}
+
+func (v AtLeastOneOfValidator) Validate(value map[string]any) error {
+	if countPresent(value, v.Fields) < 1 {
+		return fmt.Errorf("at least one of %v must be present", strings.Join(v.Fields, ", "))
+	}
+	return nil
+}
+
+func (v AtLeastOneOfValidator) Describe() Description {
+	return Description{
+		Kind:    "object.at_least_one_of",
+		Params:  map[string]any{"fields": v.Fields},
+		Summary: fmt.Sprintf("at least one of %v is required", joinValues(v.Fields)),
+	}
+}
+
+type ExactlyOneOfValidator struct {
+	Fields []string
+}
+
+func (v ExactlyOneOfValidator) Validate(value map[string]any) error {
+	if countPresent(value, v.Fields) != 1 {
+		return fmt.Errorf("exactly one of %v must be present", strings.Join(v.Fields, ", "))
+	}
+	return nil
+}
+
+func (v ExactlyOneOfValidator) Describe() Description {
+	return Description{
+		Kind:    "object.exactly_one_of",
+		Params:  map[string]any{"fields": v.Fields},
+		Summary: fmt.Sprintf("exactly one of %v is required", joinValues(v.Fields)),
+	}
+}
+
+type MutuallyExclusiveValidator struct {
+	Fields []string
+}
+
+func (v MutuallyExclusiveValidator) Validate(value map[string]any) error {
+	if countPresent(value, v.Fields) > 1 {
+		return fmt.Errorf("only one of %v may be present", strings.Join(v.Fields, ", "))
+	}
+	return nil
+}
+
+func (v MutuallyExclusiveValidator) Describe() Description {
+	return Description{
+		Kind:    "object.mutually_exclusive",
+		Params:  map[string]any{"fields": v.Fields},
+		Summary: fmt.Sprintf("only one of %v may be present", joinValues(v.Fields)),
+	}
+}
+
+func countPresent(value map[string]any, fields []string) int {
+	count := 0
+	for _, field := range fields {
+		if hasKey(value, field) {
+			count++
+		}
+	}
+	return count
+}
+
+func compareFields(value map[string]any, field string, other string) (int, bool) {
+	a, aOk := value[field]
+	b, bOk := value[other]
+	if !aOk || !bOk {
+		return 0, false
+	}
+	return compareValues(a, b)
+}
+
+// compareValues orders two validated values of the same kind, ints and
+// floats being the same kind.
+func compareValues(a any, b any) (int, bool) {
+	switch a := a.(type) {
+	case int:
+		return compareValues(float64(a), b)
+	case float64:
+		switch b := b.(type) {
+		case int:
+			return compareFloats(a, float64(b)), true
+		case float64:
+			return compareFloats(a, b), true
+		}
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b), true
+		}
+	case time.Duration:
+		if b, ok := b.(time.Duration); ok {
+			return compareFloats(float64(a), float64(b)), true
+		}
+	case Date:
+		if b, ok := b.(Date); ok {
+			return a.Compare(b), true
+		}
+	case TimeOfDay:
+		if b, ok := b.(TimeOfDay); ok {
+			return a.Compare(b), true
+		}
+	case YearMonth:
+		if b, ok := b.(YearMonth); ok {
+			return a.Compare(b), true
+		}
+	}
+	return 0, false
+}
+
+func compareFloats(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ObjectField validates a nested map with schema. Its errors are reported
+// under the path of the field.
+func ObjectField(name string, schema Schema) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: schema.Describe(),
+		Validate: func(value any, opts ...Option) (any, error) {
+			mapValue, ok := value.(map[string]any)
+			if !ok {
+				return nil, typeError("value is not an object")
+			}
+			return schema.Validate(mapValue, withoutPresence(opts)...)
+		},
+	}
+}
+
+// fieldErrors returns the field errors held by err, or err itself as an
+// error of the whole object.
+func fieldErrors(err error) Errors {
+	var errs Errors
+	if errors.As(err, &errs) {
+		return errs
+	}
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return Errors{fieldErr}
+	}
+	return Errors{{Err: err}}
+}
+
+// PrefixErrors reports err under name, keeping the paths of the field errors it
+// holds. Code generated by validator-gen uses it to build its Errors.
+func PrefixErrors(name string, err error) Errors {
+	errs := fieldErrors(err)
+
+	prefixed := make(Errors, 0, len(errs))
+	for _, fieldErr := range errs {
+		path := append([]string{name}, fieldErr.Path...)
+		prefixed = append(prefixed, &FieldError{Path: path, Err: fieldErr.Err})
+	}
+	return prefixed
+}
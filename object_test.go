@@ -0,0 +1,222 @@
+package validator_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func objectValidatorTests() {
+	Describe("EqualFieldValidator", func() {
+		schema := validator.Schema{
+			Fields: []validator.Field{
+				validator.StringField("password", validator.StringValidators{}),
+				validator.StringField("password_confirmation", validator.StringValidators{}),
+			},
+			Rules: validator.ObjectValidators{
+				validator.EqualFieldValidator{Field: "password_confirmation", Other: "password"},
+			},
+		}
+
+		It("should accept a matching confirmation", func() {
+			// arrange
+			value := map[string]any{"password": "hunter2", "password_confirmation": "hunter2"}
+
+			// act
+			_, err := schema.Validate(value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should attach a mismatch to the confirmation field", func() {
+			// arrange
+			value := map[string]any{"password": "hunter2", "password_confirmation": "hunter3"}
+
+			// act
+			_, err := schema.Validate(value)
+
+			// assert
+			var errs validator.Errors
+			Expect(errors.As(err, &errs)).To(BeTrue())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field()).To(Equal("password_confirmation"))
+			Expect(errs[0].Err.Error()).To(Equal("value must be equal to password"))
+		})
+	})
+
+	Describe("LessThanFieldValidator", func() {
+		It("should reject a start date after the end date", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.DateField("start_date", validator.DateValidators{}),
+					validator.DateField("end_date", validator.DateValidators{}),
+				},
+				Rules: validator.ObjectValidators{
+					validator.LessThanFieldValidator{Field: "start_date", Other: "end_date"},
+				},
+			}
+			value := map[string]any{"start_date": "2024-05-03", "end_date": "2024-05-01"}
+
+			// act
+			_, err := schema.Validate(value)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("start_date: value must be less than end_date"))
+		})
+
+		DescribeTable("should compare a discount with a price",
+			func(discount any, valid bool) {
+				// arrange
+				schema := validator.Schema{
+					Fields: []validator.Field{
+						validator.FloatField("price", validator.FloatValidators{}),
+						validator.FloatField("discount", validator.FloatValidators{}),
+					},
+					Rules: validator.ObjectValidators{
+						validator.LessThanFieldValidator{Field: "discount", Other: "price", OrEqual: true},
+					},
+				}
+
+				// act
+				_, err := schema.Validate(map[string]any{"price": 10, "discount": discount})
+
+				// assert
+				if valid {
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(Equal("discount: value must not be greater than price"))
+				}
+			},
+			Entry("below the price", 5.5, true),
+			Entry("equal to the price", 10, true),
+			Entry("above the price", 10.5, false),
+		)
+	})
+
+	Describe("GreaterThanFieldValidator", func() {
+		It("should reject a max below the min", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.IntField("min", validator.IntValidators{}),
+					validator.IntField("max", validator.IntValidators{}),
+				},
+				Rules: validator.ObjectValidators{
+					validator.GreaterThanFieldValidator{Field: "max", Other: "min"},
+				},
+			}
+
+			// act
+			_, err := schema.Validate(map[string]any{"min": 5, "max": 5})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("max: value must be greater than min"))
+		})
+	})
+
+	Describe("presence rules", func() {
+		fields := []validator.Field{
+			validator.StringField("email", validator.StringValidators{}).Optional(),
+			validator.StringField("phone", validator.StringValidators{}).Optional(),
+		}
+
+		DescribeTable("should count the present fields",
+			func(rule validator.ObjectValidators, value map[string]any, message string) {
+				// arrange
+				schema := validator.Schema{Fields: fields, Rules: rule}
+
+				// act
+				_, err := schema.Validate(value)
+
+				// assert
+				if message == "" {
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(Equal(message))
+				}
+			},
+			Entry("at least one of with one field",
+				validator.ObjectValidators{validator.AtLeastOneOfValidator{Fields: []string{"email", "phone"}}},
+				map[string]any{"email": "john@doe.com"}, ""),
+			Entry("at least one of without field",
+				validator.ObjectValidators{validator.AtLeastOneOfValidator{Fields: []string{"email", "phone"}}},
+				map[string]any{}, "at least one of email, phone must be present"),
+			Entry("exactly one of with two fields",
+				validator.ObjectValidators{validator.ExactlyOneOfValidator{Fields: []string{"email", "phone"}}},
+				map[string]any{"email": "john@doe.com", "phone": "+33 1 09 75 83 51"},
+				"exactly one of email, phone must be present"),
+			Entry("mutually exclusive without field",
+				validator.ObjectValidators{validator.MutuallyExclusiveValidator{Fields: []string{"email", "phone"}}},
+				map[string]any{}, ""),
+			Entry("mutually exclusive with two fields",
+				validator.ObjectValidators{validator.MutuallyExclusiveValidator{Fields: []string{"email", "phone"}}},
+				map[string]any{"email": "john@doe.com", "phone": "+33 1 09 75 83 51"},
+				"only one of email, phone may be present"),
+		)
+
+		It("should not count empty values read as missing", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields:      fields,
+				Rules:       validator.ObjectValidators{validator.AtLeastOneOfValidator{Fields: []string{"email", "nickname"}}},
+				Options:     []validator.Option{validator.WithEmptyAsMissing()},
+				UnknownKeys: validator.UnknownKeysPassthrough,
+			}
+
+			// act
+			_, err := schema.Validate(map[string]any{"email": "", "nickname": ""})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("at least one of email, nickname must be present"))
+		})
+
+		It("should not run the rules while a field is invalid", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: fields,
+				Rules:  validator.ObjectValidators{validator.AtLeastOneOfValidator{Fields: []string{"email", "phone"}}},
+			}
+
+			// act
+			_, err := schema.Validate(map[string]any{"email": true})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("email: value is not a string"))
+		})
+	})
+
+	Describe("ObjectField", func() {
+		It("should report the errors of a nested object under its path", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.ObjectField("address", validator.Schema{
+						Fields: []validator.Field{
+							validator.StringField("city", validator.StringValidators{}),
+						},
+					}),
+				},
+			}
+
+			// act
+			_, err := schema.Validate(map[string]any{"address": map[string]any{"city": true}})
+
+			// assert
+			var errs validator.Errors
+			Expect(errors.As(err, &errs)).To(BeTrue())
+			Expect(errs[0].Path).To(Equal([]string{"address", "city"}))
+			Expect(err.Error()).To(Equal("address.city: value is not a string"))
+		})
+	})
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"context"
+	"time"
+)
+
+type Option func(*options)
+
+type options struct {
+	timeLayouts []string
+	location    *time.Location
+	utc         bool
+	epoch       EpochUnit
+	clock       Clock
+
+	canonicalUUID  bool
+	snowflakeEpoch time.Time
+	nanoIDSize     int
+	nanoIDAlphabet string
+	timestampCheck bool
+	timestampSkew  time.Duration
+
+	boolProfile BoolProfile
+	coercion    *Coercion
+
+	presence       PresenceValidators
+	rejectNull     bool
+	emptyAsMissing bool
+
+	ctx context.Context
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		timeLayouts: DefaultTimeLayouts,
+		location:    time.UTC,
+
+		snowflakeEpoch: TwitterEpoch,
+		nanoIDSize:     21,
+		nanoIDAlphabet: NanoIDAlphabet,
+		timestampCheck: true,
+		timestampSkew:  DefaultTimestampSkew,
+
+		boolProfile: BoolDefaultProfile,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
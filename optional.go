@@ -0,0 +1,49 @@
+package validator
+
+import "fmt"
+
+func validateMapOrNil[T, R any](
+	name string,
+	value map[string]any,
+	validate func(value any, rules R, opts ...Option) (T, error),
+	rules R,
+	opts []Option,
+) (*T, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	val, err := validate(rawValue, rules, opts...)
+
+	return &val, err
+}
+
+// validateMapOrDefaultFunc falls back on defaultValue when the key is missing,
+// validating the default like any other value so a bad default is caught.
+func validateMapOrDefaultFunc[T, R any](
+	name string,
+	value map[string]any,
+	validate func(value any, rules R, opts ...Option) (T, error),
+	rules R,
+	defaultValue func() T,
+	opts []Option,
+) (T, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if !ok {
+		val, err := validate(defaultValue(), rules, opts...)
+		if err != nil {
+			return val, fmt.Errorf("invalid default: %w", err)
+		}
+		return val, nil
+	}
+
+	return validate(rawValue, rules, opts...)
+}
@@ -0,0 +1,206 @@
+package validator_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gungun974/validator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func optionalTests() {
+	Describe("ValidateMapIntOrNil", func() {
+		It("should return nil when the key is missing", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			result, err := validator.ValidateMapIntOrNil("page", value, validator.IntValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should return a pointer to the value when the key is present", func() {
+			// arrange
+			value := map[string]any{"page": 3.0}
+
+			// act
+			result, err := validator.ValidateMapIntOrNil("page", value, validator.IntValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(*result).To(Equal(3))
+		})
+	})
+
+	Describe("ValidateMapFloatOrNil", func() {
+		It("should still validate a present value", func() {
+			// arrange
+			value := map[string]any{"ratio": "garbage"}
+
+			// act
+			_, err := validator.ValidateMapFloatOrNil("ratio", value, validator.FloatValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a number"))
+		})
+	})
+
+	Describe("ValidateMapIntOrDefault", func() {
+		It("should return the default when the key is missing", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			result, err := validator.ValidateMapIntOrDefault("page", value, validator.IntValidators{}, 1)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(1))
+		})
+
+		It("should return the value when the key is present", func() {
+			// arrange
+			value := map[string]any{"page": 7}
+
+			// act
+			result, err := validator.ValidateMapIntOrDefault("page", value, validator.IntValidators{}, 1)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(7))
+		})
+
+		It("should catch a default breaking the rules", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			_, err := validator.ValidateMapIntOrDefault("page", value, validator.IntValidators{
+				validator.IntMaxValidator{Max: 10},
+			}, 20)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("invalid default: value must not be greater than 10"))
+		})
+	})
+
+	Describe("ValidateMapStringOrDefault", func() {
+		It("should return the default when the key is missing", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			result, err := validator.ValidateMapStringOrDefault("sort", value, validator.StringValidators{}, "name")
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal("name"))
+		})
+	})
+
+	Describe("ValidateMapUUIDOrDefaultFunc", func() {
+		It("should only compute the default when the key is missing", func() {
+			// arrange
+			id := uuid.MustParse("c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2")
+			calls := 0
+			defaultValue := func() uuid.UUID {
+				calls++
+				return uuid.New()
+			}
+
+			// act
+			result, err := validator.ValidateMapUUIDOrDefaultFunc(
+				"id",
+				map[string]any{"id": id.String()},
+				defaultValue,
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(id))
+			Expect(calls).To(Equal(0))
+		})
+
+		It("should compute the default when the key is missing", func() {
+			// arrange
+			id := uuid.MustParse("c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2")
+
+			// act
+			result, err := validator.ValidateMapUUIDOrDefaultFunc(
+				"id",
+				map[string]any{},
+				func() uuid.UUID { return id },
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(id))
+		})
+	})
+
+	Describe("ValidateMapTimeOrDefaultFunc", func() {
+		It("should validate the computed default", func() {
+			// arrange
+			clock := validator.FixedClock{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+
+			// act
+			_, err := validator.ValidateMapTimeOrDefaultFunc(
+				"published_at",
+				map[string]any{},
+				validator.TimeValidators{validator.TimePastValidator{Clock: clock}},
+				func() time.Time { return clock.Time.Add(time.Hour) },
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(validator.IsLookupError(err)).To(BeFalse())
+		})
+	})
+
+	Describe("ValidateMapDurationOrDefault", func() {
+		It("should return the default when the key is missing", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			result, err := validator.ValidateMapDurationOrDefault(
+				"timeout",
+				value,
+				validator.DurationValidators{},
+				30*time.Second,
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(30 * time.Second))
+		})
+	})
+
+	Describe("ValidateMapSnowflakeOrDefault", func() {
+		It("should accept a Snowflake as default", func() {
+			// arrange
+			value := map[string]any{}
+			defaultValue, _ := validator.ValidateSnowflake("1541815603606036480", validator.TimeValidators{})
+
+			// act
+			result, err := validator.ValidateMapSnowflakeOrDefault(
+				"id",
+				value,
+				validator.TimeValidators{},
+				defaultValue,
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(defaultValue))
+		})
+	})
+}
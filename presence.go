@@ -0,0 +1,209 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type PresenceValidators []presenceValidator
+
+// presenceValidator decides whether the key name may be missing from value,
+// or must be, depending on the other keys of value.
+type presenceValidator interface {
+	Validate(name string, value map[string]any) error
+}
+
+// WithPresence checks rules before a key is looked up by a ValidateMap* function.
+func WithPresence(rules PresenceValidators) Option {
+	return func(o *options) {
+		o.presence = append(o.presence, rules...)
+	}
+}
+
+type RequiredIfValidator struct {
+	Key   string
+	Value any
+}
+
+func (v RequiredIfValidator) Validate(name string, value map[string]any) error {
+	if !hasKey(value, name) && keyEquals(value, v.Key, v.Value) {
+		return &CodeError{Code: CodeRequired, Err: fmt.Errorf("missing key \"%v\" required when %v is %v", name, v.Key, v.Value)}
+	}
+	return nil
+}
+
+func (v RequiredIfValidator) Describe() Description {
+	return Description{
+		Kind:    "presence.required_if",
+		Params:  map[string]any{"key": v.Key, "value": v.Value},
+		Summary: fmt.Sprintf("required when %v is %v", v.Key, v.Value),
+	}
+}
+
+type RequiredUnlessValidator struct {
+	Key   string
+	Value any
+}
+
+func (v RequiredUnlessValidator) Validate(name string, value map[string]any) error {
+	if !hasKey(value, name) && !keyEquals(value, v.Key, v.Value) {
+		return &CodeError{Code: CodeRequired, Err: fmt.Errorf("missing key \"%v\" required unless %v is %v", name, v.Key, v.Value)}
+	}
+	return nil
+}
+
+func (v RequiredUnlessValidator) Describe() Description {
+	return Description{
+		Kind:    "presence.required_unless",
+		Params:  map[string]any{"key": v.Key, "value": v.Value},
+		Summary: fmt.Sprintf("required unless %v is %v", v.Key, v.Value),
+	}
+}
+
+// RequiredWithValidator requires the key when any of Keys is present.
+type RequiredWithValidator struct {
+	Keys []string
+}
+
+func (v RequiredWithValidator) Validate(name string, value map[string]any) error {
+	if hasKey(value, name) {
+		return nil
+	}
+	for _, key := range v.Keys {
+		if hasKey(value, key) {
+			return &CodeError{Code: CodeRequired, Err: fmt.Errorf("missing key \"%v\" required with %v", name, strings.Join(v.Keys, ", "))}
+		}
+	}
+	return nil
+}
+
+func (v RequiredWithValidator) Describe() Description {
+	return Description{
+		Kind:    "presence.required_with",
+		Params:  map[string]any{"keys": v.Keys},
+		Summary: fmt.Sprintf("required with %v", joinValues(v.Keys)),
+	}
+}
+
+// RequiredWithoutValidator requires the key when any of Keys is missing.
+type RequiredWithoutValidator struct {
+	Keys []string
+}
+
+func (v RequiredWithoutValidator) Validate(name string, value map[string]any) error {
+	if hasKey(value, name) {
+		return nil
+	}
+	for _, key := range v.Keys {
+		if !hasKey(value, key) {
+			return &CodeError{Code: CodeRequired, Err: fmt.Errorf("missing key \"%v\" required without %v", name, strings.Join(v.Keys, ", "))}
+		}
+	}
+	return nil
+}
+
+func (v RequiredWithoutValidator) Describe() Description {
+	return Description{
+		Kind:    "presence.required_without",
+		Params:  map[string]any{"keys": v.Keys},
+		Summary: fmt.Sprintf("required without %v", joinValues(v.Keys)),
+	}
+}
+
+type ForbiddenIfValidator struct {
+	Key   string
+	Value any
+}
+
+func (v ForbiddenIfValidator) Validate(name string, value map[string]any) error {
+	if hasKey(value, name) && keyEquals(value, v.Key, v.Value) {
+		return fmt.Errorf("key \"%v\" is forbidden when %v is %v", name, v.Key, v.Value)
+	}
+	return nil
+}
+
+func (v ForbiddenIfValidator) Describe() Description {
+	return Description{
+		Kind:    "presence.forbidden_if",
+		Params:  map[string]any{"key": v.Key, "value": v.Value},
+		Summary: fmt.Sprintf("forbidden when %v is %v", v.Key, v.Value),
+	}
+}
+
+func keyEquals(value map[string]any, key string, expected any) bool {
+	rawValue, ok := value[key]
+	if !ok {
+		return false
+	}
+	if order, ok := compareValues(rawValue, expected); ok {
+		return order == 0
+	}
+	return reflect.DeepEqual(rawValue, expected)
+}
+
+// lookupKey returns the value of the key name and whether it is present,
+// once the presence rules of opts are satisfied.
+func lookupKey(name string, value map[string]any, opts []Option) (any, bool, error) {
+	rawValue, state, err := lookupState(name, value, opts)
+	return rawValue, state == keyPresent, err
+}
+
+// lookupState applies the presence rules of opts, then reads an explicit null
+// as missing unless WithRejectNull is set.
+func lookupState(name string, value map[string]any, opts []Option) (any, keyState, error) {
+	o := newOptions(opts)
+
+	if len(o.presence) > 0 {
+		view := presenceView(value, o)
+		for _, rule := range o.presence {
+			if err := rule.Validate(name, view); err != nil {
+				return nil, keyMissing, err
+			}
+		}
+	}
+
+	rawValue, ok := value[name]
+	if !ok {
+		return nil, keyMissing, nil
+	}
+	if rawValue == nil {
+		if o.rejectNull {
+			return nil, keyNull, &CodeError{Code: CodeNull, Err: fmt.Errorf("key \"%v\" must not be null", name)}
+		}
+		return nil, keyNull, nil
+	}
+	if o.emptyAsMissing && rawValue == "" {
+		return nil, keyMissing, nil
+	}
+	return rawValue, keyPresent, nil
+}
+
+// presenceView returns value as the presence and object rules see it, without
+// the empty strings WithEmptyAsMissing reads as missing keys.
+func presenceView(value map[string]any, o options) map[string]any {
+	if !o.emptyAsMissing {
+		return value
+	}
+	view := make(map[string]any, len(value))
+	for key, rawValue := range value {
+		if rawValue != "" {
+			view[key] = rawValue
+		}
+	}
+	return view
+}
+
+// hasKey reports whether key holds a value other than an explicit null.
+func hasKey(value map[string]any, key string) bool {
+	rawValue, ok := value[key]
+	return ok && rawValue != nil
+}
+
+// withoutPresence drops the presence rules of opts before they reach the
+// lookups of a nested map, whose keys they were not written for.
+func withoutPresence(opts []Option) []Option {
+	return append(opts[:len(opts):len(opts)], func(o *options) {
+		o.presence = nil
+	})
+}
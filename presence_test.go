@@ -0,0 +1,176 @@
+package validator_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func presenceTests() {
+	Describe("ValidateMapStringOrNil with presence rules", func() {
+		requiredIfBusiness := validator.WithPresence(validator.PresenceValidators{
+			validator.RequiredIfValidator{Key: "account_type", Value: "business"},
+		})
+
+		It("should require the key when the condition holds", func() {
+			// arrange
+			value := map[string]any{"account_type": "business"}
+
+			// act
+			_, err := validator.ValidateMapStringOrNil("company_name", value, validator.StringValidators{}, requiredIfBusiness)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing key \"company_name\" required when account_type is business"))
+		})
+
+		It("should return nil when the condition does not hold", func() {
+			// arrange
+			value := map[string]any{"account_type": "personal"}
+
+			// act
+			result, err := validator.ValidateMapStringOrNil("company_name", value, validator.StringValidators{}, requiredIfBusiness)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should require the key when it is empty and read as missing", func() {
+			// arrange
+			value := map[string]any{"account_type": "business", "company_name": ""}
+
+			// act
+			_, err := validator.ValidateMapStringOrNil(
+				"company_name", value, validator.StringValidators{}, requiredIfBusiness, validator.WithEmptyAsMissing(),
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("missing key \"company_name\" required when account_type is business"))
+		})
+
+		It("should not match a condition on a key that is empty and read as missing", func() {
+			// arrange
+			requiredWithCompany := validator.WithPresence(validator.PresenceValidators{
+				validator.RequiredWithValidator{Keys: []string{"company_name"}},
+			})
+			value := map[string]any{"company_name": ""}
+
+			// act
+			result, err := validator.ValidateMapStringOrNil(
+				"vat_number", value, validator.StringValidators{}, requiredWithCompany, validator.WithEmptyAsMissing(),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should return the value when the key is present", func() {
+			// arrange
+			value := map[string]any{"account_type": "business", "company_name": "ACME"}
+
+			// act
+			result, err := validator.ValidateMapStringOrNil("company_name", value, validator.StringValidators{}, requiredIfBusiness)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(*result).To(Equal("ACME"))
+		})
+	})
+
+	DescribeTable("presence rules",
+		func(rule validator.PresenceValidators, value map[string]any, message string) {
+			// act
+			_, err := validator.ValidateMapStringOrNil("quantity", value, validator.StringValidators{}, validator.WithPresence(rule))
+
+			// assert
+			if message == "" {
+				Expect(err).ShouldNot(HaveOccurred())
+			} else {
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal(message))
+			}
+		},
+		Entry("required if with a number of another type",
+			validator.PresenceValidators{validator.RequiredIfValidator{Key: "count", Value: 1}},
+			map[string]any{"count": 1.0},
+			"missing key \"quantity\" required when count is 1"),
+		Entry("required unless the condition holds",
+			validator.PresenceValidators{validator.RequiredUnlessValidator{Key: "mode", Value: "free"}},
+			map[string]any{"mode": "free"}, ""),
+		Entry("required unless the condition does not hold",
+			validator.PresenceValidators{validator.RequiredUnlessValidator{Key: "mode", Value: "free"}},
+			map[string]any{"mode": "paid"},
+			"missing key \"quantity\" required unless mode is free"),
+		Entry("required with a present key",
+			validator.PresenceValidators{validator.RequiredWithValidator{Keys: []string{"unit", "price"}}},
+			map[string]any{"price": 3},
+			"missing key \"quantity\" required with unit, price"),
+		Entry("required with missing keys",
+			validator.PresenceValidators{validator.RequiredWithValidator{Keys: []string{"unit", "price"}}},
+			map[string]any{}, ""),
+		Entry("required without a missing key",
+			validator.PresenceValidators{validator.RequiredWithoutValidator{Keys: []string{"total"}}},
+			map[string]any{},
+			"missing key \"quantity\" required without total"),
+		Entry("forbidden if the condition holds",
+			validator.PresenceValidators{validator.ForbiddenIfValidator{Key: "mode", Value: "free"}},
+			map[string]any{"mode": "free", "quantity": 3},
+			"key \"quantity\" is forbidden when mode is free"),
+		Entry("forbidden if the condition does not hold",
+			validator.PresenceValidators{validator.ForbiddenIfValidator{Key: "mode", Value: "free"}},
+			map[string]any{"mode": "paid", "quantity": 3}, ""),
+	)
+
+	Describe("Schema with presence rules", func() {
+		It("should make an optional field conditionally required", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.StringField("account_type", validator.StringValidators{}),
+					validator.StringField("company_name", validator.StringValidators{}).Optional().With(
+						validator.WithPresence(validator.PresenceValidators{
+							validator.RequiredIfValidator{Key: "account_type", Value: "business"},
+						}),
+					),
+				},
+			}
+
+			// act
+			_, err := schema.Validate(map[string]any{"account_type": "business"})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal(
+				"company_name: missing key \"company_name\" required when account_type is business",
+			))
+		})
+
+		It("should read empty form inputs as missing", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields: []validator.Field{
+					validator.StringField("account_type", validator.StringValidators{}),
+					validator.StringField("company_name", validator.StringValidators{}).Optional().With(
+						validator.WithPresence(validator.PresenceValidators{
+							validator.RequiredIfValidator{Key: "account_type", Value: "business"},
+						}),
+					),
+				},
+				Options: []validator.Option{validator.WithEmptyAsMissing()},
+			}
+
+			// act
+			_, err := schema.Validate(map[string]any{"account_type": "business", "company_name": ""})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal(
+				"company_name: missing key \"company_name\" required when account_type is business",
+			))
+		})
+	})
+}
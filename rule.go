@@ -0,0 +1,191 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rule is any rule of values of type T, such as the elements of a
+// StringValidators slice.
+type Rule[T any] interface {
+	Validate(value T) error
+}
+
+// RuleFunc adapts a function into a Rule.
+type RuleFunc[T any] func(value T) error
+
+func (f RuleFunc[T]) Validate(value T) error {
+	return f(value)
+}
+
+type (
+	StringFunc    = RuleFunc[string]
+	IntFunc       = RuleFunc[int]
+	FloatFunc     = RuleFunc[float64]
+	BoolFunc      = RuleFunc[bool]
+	TimeFunc      = RuleFunc[time.Time]
+	DurationFunc  = RuleFunc[time.Duration]
+	DateFunc      = RuleFunc[Date]
+	TimeOfDayFunc = RuleFunc[TimeOfDay]
+	YearMonthFunc = RuleFunc[YearMonth]
+	UUIDFunc      = RuleFunc[uuid.UUID]
+)
+
+func validateRule[T any](ctx context.Context, rule Rule[T], value T) error {
+	return applyRules(ctx, []Rule[T]{rule}, value)
+}
+
+type allRule[T any] struct {
+	rules []Rule[T]
+}
+
+// All is satisfied when every rule is, reporting the first failing one.
+func All[T any](rules ...Rule[T]) Rule[T] {
+	return allRule[T]{rules: rules}
+}
+
+func (r allRule[T]) Validate(value T) error {
+	return r.ValidateContext(context.Background(), value)
+}
+
+func (r allRule[T]) ValidateContext(ctx context.Context, value T) error {
+	return applyRules(ctx, r.rules, value)
+}
+
+func (r allRule[T]) Describe() Description {
+	rules := DescribeRules(r.rules)
+	return Description{Kind: "all", Summary: joinSummaries(rules, ", "), Rules: rules}
+}
+
+type anyRule[T any] struct {
+	rules []Rule[T]
+}
+
+// Any is satisfied when at least one rule is, reporting every failure
+// otherwise. Like an empty list of rules, Any without rules is satisfied.
+func Any[T any](rules ...Rule[T]) Rule[T] {
+	return anyRule[T]{rules: rules}
+}
+
+func (r anyRule[T]) Validate(value T) error {
+	return r.ValidateContext(context.Background(), value)
+}
+
+func (r anyRule[T]) ValidateContext(ctx context.Context, value T) error {
+	if len(r.rules) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(r.rules))
+	for _, rule := range r.rules {
+		err := validateRule(ctx, rule, value)
+		if err == nil {
+			return nil
+		}
+		if IsLookupError(err) {
+			return err
+		}
+		messages = append(messages, err.Error())
+	}
+	return errors.New(strings.Join(messages, " or "))
+}
+
+func (r anyRule[T]) Describe() Description {
+	rules := DescribeRules(r.rules)
+	return Description{Kind: "any", Summary: joinSummaries(rules, " or "), Rules: rules}
+}
+
+type notRule[T any] struct {
+	rule    Rule[T]
+	message string
+}
+
+// Not is satisfied when rule is not, failing with message otherwise.
+func Not[T any](rule Rule[T], message string) Rule[T] {
+	return notRule[T]{rule: rule, message: message}
+}
+
+func (r notRule[T]) Validate(value T) error {
+	return r.ValidateContext(context.Background(), value)
+}
+
+func (r notRule[T]) ValidateContext(ctx context.Context, value T) error {
+	err := validateRule(ctx, r.rule, value)
+	if IsLookupError(err) {
+		return err
+	}
+	if err == nil {
+		return errors.New(r.message)
+	}
+	return nil
+}
+
+func (r notRule[T]) Describe() Description {
+	return Description{
+		Kind:    "not",
+		Params:  map[string]any{"message": r.message},
+		Summary: r.message,
+		Rules:   DescribeRules([]Rule[T]{r.rule}),
+	}
+}
+
+type whenRule[T any] struct {
+	predicate func(value T) bool
+	rules     []Rule[T]
+}
+
+// When only runs rules on the values matching predicate.
+func When[T any](predicate func(value T) bool, rules ...Rule[T]) Rule[T] {
+	return whenRule[T]{predicate: predicate, rules: rules}
+}
+
+func (r whenRule[T]) Validate(value T) error {
+	return r.ValidateContext(context.Background(), value)
+}
+
+func (r whenRule[T]) ValidateContext(ctx context.Context, value T) error {
+	if !r.predicate(value) {
+		return nil
+	}
+	return applyRules(ctx, r.rules, value)
+}
+
+func (r whenRule[T]) Describe() Description {
+	rules := DescribeRules(r.rules)
+	return Description{Kind: "when", Summary: "when applicable, " + joinSummaries(rules, ", "), Rules: rules}
+}
+
+type eachRule[T any] struct {
+	rules []Rule[T]
+}
+
+// Each runs rules on every item of a slice.
+func Each[T any](rules ...Rule[T]) Rule[[]T] {
+	return eachRule[T]{rules: rules}
+}
+
+func (r eachRule[T]) Validate(value []T) error {
+	return r.ValidateContext(context.Background(), value)
+}
+
+func (r eachRule[T]) ValidateContext(ctx context.Context, value []T) error {
+	for i, item := range value {
+		if err := applyRules(ctx, r.rules, item); err != nil {
+			if IsLookupError(err) {
+				return err
+			}
+			return fmt.Errorf("item %v: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r eachRule[T]) Describe() Description {
+	rules := DescribeRules(r.rules)
+	return Description{Kind: "each", Summary: "each item " + joinSummaries(rules, ", "), Rules: rules}
+}
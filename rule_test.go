@@ -0,0 +1,214 @@
+package validator_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gungun974/validator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func ruleTests() {
+	Describe("StringFunc", func() {
+		It("should run the function as a rule", func() {
+			// arrange
+			rule := validator.StringFunc(func(value string) error {
+				if !strings.HasPrefix(value, "sk_") {
+					return errors.New("value must start with sk_")
+				}
+				return nil
+			})
+
+			// act
+			_, err := validator.ValidateString("pk_123", validator.StringValidators{rule})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must start with sk_"))
+		})
+	})
+
+	Describe("IntFunc", func() {
+		It("should run the function as a rule", func() {
+			// arrange
+			rule := validator.IntFunc(func(value int) error {
+				if value%2 != 0 {
+					return errors.New("value must be even")
+				}
+				return nil
+			})
+
+			// act
+			result, err := validator.ValidateInt(4, validator.IntValidators{rule})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(4))
+		})
+	})
+
+	DescribeTable("Any",
+		func(value string, message string) {
+			// act
+			_, err := validator.ValidateString(value, validator.StringValidators{
+				validator.Any[string](
+					validator.StringMaxValidator{Max: 0},
+					validator.StringPhoneValidator{},
+				),
+			})
+
+			// assert
+			if message == "" {
+				Expect(err).ShouldNot(HaveOccurred())
+			} else {
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal(message))
+			}
+		},
+		Entry("an empty string", "", ""),
+		Entry("a phone number", "+33 1 09 75 83 51", ""),
+		Entry("garbage", "hoi",
+			"value length must not be greater than 0 or value is not an international phone number"),
+	)
+
+	It("should accept any value with Any without rules", func() {
+		// act
+		_, err := validator.ValidateString("hoi", validator.StringValidators{validator.Any[string]()})
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Describe("All", func() {
+		It("should report the first failing rule", func() {
+			// act
+			_, err := validator.ValidateInt(42, validator.IntValidators{
+				validator.All[int](
+					validator.IntMinValidator{Min: 0},
+					validator.IntMaxValidator{Max: 10},
+					FakeErrorIntValidator{},
+				),
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be greater than 10"))
+		})
+	})
+
+	Describe("Not", func() {
+		It("should fail with its message when the rule passes", func() {
+			// act
+			_, err := validator.ValidateString("john@doe.com", validator.StringValidators{
+				validator.Not[string](validator.StringEmailValidator{}, "value must not be an email"),
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be an email"))
+		})
+
+		It("should pass when the rule fails", func() {
+			// act
+			result, err := validator.ValidateString("gungun974", validator.StringValidators{
+				validator.Not[string](validator.StringEmailValidator{}, "value must not be an email"),
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal("gungun974"))
+		})
+	})
+
+	Describe("When", func() {
+		notEmpty := func(value string) bool { return value != "" }
+
+		It("should skip the rules when the predicate does not match", func() {
+			// act
+			_, err := validator.ValidateString("", validator.StringValidators{
+				validator.When[string](notEmpty, validator.StringPhoneValidator{}),
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should run the rules when the predicate matches", func() {
+			// act
+			_, err := validator.ValidateString("hoi", validator.StringValidators{
+				validator.When[string](notEmpty, validator.StringPhoneValidator{}),
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not an international phone number"))
+		})
+	})
+
+	Describe("ValidateSlice", func() {
+		It("should validate every item", func() {
+			// arrange
+			value := []any{"john@doe.com", "jane@doe.com"}
+
+			// act
+			result, err := validator.ValidateSlice(
+				value,
+				validator.ValidateString,
+				validator.StringValidators{validator.StringEmailValidator{}},
+				nil,
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal([]string{"john@doe.com", "jane@doe.com"}))
+		})
+
+		It("should report the failing item", func() {
+			// arrange
+			value := []any{"john@doe.com", "hoi"}
+
+			// act
+			_, err := validator.ValidateSlice(
+				value,
+				validator.ValidateString,
+				validator.StringValidators{validator.StringEmailValidator{}},
+				nil,
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("item 1: value is not an email"))
+		})
+
+		It("should run Each and slice rules on the result", func() {
+			// arrange
+			value := map[string]any{"scores": []int{3, 12}}
+
+			// act
+			_, err := validator.ValidateMapSlice(
+				"scores",
+				value,
+				validator.ValidateInt,
+				validator.IntValidators{},
+				[]validator.Rule[[]int]{
+					validator.Each[int](validator.IntMaxValidator{Max: 10}),
+				},
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("item 1: value must not be greater than 10"))
+		})
+
+		It("should not return a slice when input is garbage", func() {
+			// act
+			_, err := validator.ValidateSlice("garbage", validator.ValidateInt, validator.IntValidators{}, nil)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a list"))
+		})
+	})
+}
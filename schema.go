@@ -0,0 +1,294 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Schema validates every declared key of a map at once and reports all the
+// invalid keys instead of stopping at the first one. Its Rules only run once
+// every field is valid.
+type Schema struct {
+	Fields []Field
+	Rules  ObjectValidators
+	// Options apply to every field, before the options given to Validate.
+	Options []Option
+	// UnknownKeys handles the input keys missing from Fields, stripping them
+	// by default.
+	UnknownKeys UnknownKeys
+	// SuggestKeys makes UnknownKeysStrict errors name the closest declared key.
+	SuggestKeys bool
+}
+
+type Field struct {
+	Name     string
+	Required bool
+	Validate func(value any, opts ...Option) (any, error)
+	// Options apply to this field only, after the options of the schema and of the call.
+	Options []Option
+	// Description describes the type and the rules of the field for Schema.Describe.
+	Description Description
+	// Presence holds the presence rules given to With, for Schema.Describe.
+	Presence PresenceValidators
+}
+
+// Optional returns a copy of the field that may be missing from the input.
+func (f Field) Optional() Field {
+	f.Required = false
+	return f
+}
+
+// With returns a copy of the field with opts added to its Options, such as
+// WithPresence to make an optional field conditionally required.
+func (f Field) With(opts ...Option) Field {
+	f.Options = append(append([]Option{}, f.Options...), opts...)
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(o.presence) > 0 {
+		f.Presence = append(append(PresenceValidators{}, f.Presence...), o.presence...)
+	}
+	return f
+}
+
+func StringField(name string, rules StringValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("string", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateString(value, rules, opts...)
+		},
+	}
+}
+
+func IntField(name string, rules IntValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("int", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateInt(value, rules, opts...)
+		},
+	}
+}
+
+func FloatField(name string, rules FloatValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("float", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateFloat(value, rules, opts...)
+		},
+	}
+}
+
+func BoolField(name string, rules BoolValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("bool", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateBool(value, rules, opts...)
+		},
+	}
+}
+
+func TimeField(name string, rules TimeValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("time", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateTime(value, rules, opts...)
+		},
+	}
+}
+
+func DurationField(name string, rules DurationValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("duration", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateDuration(value, rules, opts...)
+		},
+	}
+}
+
+func DateField(name string, rules DateValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("date", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateDate(value, rules, opts...)
+		},
+	}
+}
+
+func TimeOfDayField(name string, rules TimeOfDayValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("time_of_day", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateTimeOfDay(value, rules, opts...)
+		},
+	}
+}
+
+func YearMonthField(name string, rules YearMonthValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("year_month", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateYearMonth(value, rules, opts...)
+		},
+	}
+}
+
+func UUIDField(name string, rules UUIDValidators) Field {
+	return Field{
+		Name:        name,
+		Required:    true,
+		Description: describeField("uuid", rules),
+		Validate: func(value any, opts ...Option) (any, error) {
+			return ValidateUUIDWith(value, rules, opts...)
+		},
+	}
+}
+
+// Validate returns the validated value of every present field, keyed by
+// name. Its error, when not nil, is an Errors, or a LookupError when a rule
+// could not reach its Lookup.
+func (s Schema) Validate(value map[string]any, opts ...Option) (map[string]any, error) {
+	fieldOpts := make([]Option, 0, len(s.Options)+len(opts))
+	fieldOpts = append(fieldOpts, s.Options...)
+	fieldOpts = append(fieldOpts, opts...)
+
+	result := make(map[string]any, len(s.Fields))
+	var errs Errors
+
+	for _, field := range s.Fields {
+		opts := fieldOpts
+		if len(field.Options) > 0 {
+			opts = append(append([]Option{}, fieldOpts...), field.Options...)
+		}
+
+		rawValue, ok, err := lookupKey(field.Name, value, opts)
+		if err != nil {
+			errs = append(errs, &FieldError{Path: []string{field.Name}, Err: err})
+			continue
+		}
+		if !ok {
+			if field.Required {
+				errs = append(errs, &FieldError{
+					Path: []string{field.Name},
+					Err:  missingKeyError(field.Name),
+				})
+			}
+			continue
+		}
+
+		val, err := field.Validate(rawValue, opts...)
+		if IsLookupError(err) {
+			return nil, err
+		}
+		if err != nil {
+			errs = append(errs, PrefixErrors(field.Name, err)...)
+			continue
+		}
+		result[field.Name] = val
+	}
+
+	if s.UnknownKeys != UnknownKeysStrip {
+		keys := s.keys()
+		for _, key := range unknownKeys(value, keys) {
+			if s.UnknownKeys == UnknownKeysPassthrough {
+				result[key] = value[key]
+				continue
+			}
+			errs = append(errs, &FieldError{
+				Path: []string{key},
+				Err:  unknownKeyError(key, keys, s.SuggestKeys),
+			})
+		}
+	}
+
+	if len(errs) == 0 && len(s.Rules) > 0 {
+		view := presenceView(result, newOptions(fieldOpts))
+		for _, rule := range s.Rules {
+			if err := rule.Validate(view); err != nil {
+				errs = append(errs, fieldErrors(err)...)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
+}
+
+func (s Schema) keys() []string {
+	keys := make([]string, 0, len(s.Fields))
+	for _, field := range s.Fields {
+		keys = append(keys, field.Name)
+	}
+	return keys
+}
+
+// ValidateContext is Validate with ctx handed to the context-aware rules.
+func (s Schema) ValidateContext(
+	ctx context.Context,
+	value map[string]any,
+	opts ...Option,
+) (map[string]any, error) {
+	return s.Validate(value, append(append([]Option{}, opts...), WithContext(ctx))...)
+}
+
+// FieldError is the error of the value at Path, the keys leading to it from
+// the validated map.
+type FieldError struct {
+	Path []string
+	Err  error
+}
+
+func (e *FieldError) Field() string {
+	return strings.Join(e.Path, ".")
+}
+
+func (e *FieldError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v: %v", e.Field(), e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, ", ")
+}
+
+func (e Errors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
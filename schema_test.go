@@ -0,0 +1,83 @@
+package validator_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func schemaTests() {
+	Describe("Schema", func() {
+		schema := validator.Schema{
+			Fields: []validator.Field{
+				validator.StringField("name", validator.StringValidators{
+					validator.StringMinValidator{Min: 3},
+				}),
+				validator.IntField("age", validator.IntValidators{}),
+				validator.StringField("nickname", validator.StringValidators{}).Optional(),
+			},
+		}
+
+		It("should return the validated values", func() {
+			// arrange
+			value := map[string]any{"name": "John", "age": 42.0, "ignored": true}
+
+			// act
+			result, err := schema.Validate(value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(map[string]any{"name": "John", "age": 42}))
+		})
+
+		It("should report every invalid field", func() {
+			// arrange
+			value := map[string]any{"name": false, "nickname": true}
+
+			// act
+			_, err := schema.Validate(value)
+
+			// assert
+			var errs validator.Errors
+			Expect(errors.As(err, &errs)).To(BeTrue())
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0].Field()).To(Equal("name"))
+			Expect(err.Error()).To(Equal(
+				"name: value is not a string, " +
+					"age: missing key \"age\", " +
+					"nickname: value is not a string",
+			))
+		})
+
+		It("should apply the Coercion of the schema", func() {
+			// arrange
+			formSchema := schema
+			formSchema.Options = []validator.Option{validator.WithCoercion(validator.CoercionForm)}
+			value := map[string]any{"name": "John", "age": "42"}
+
+			// act
+			result, err := formSchema.Validate(value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result["age"]).To(Equal(42))
+		})
+
+		It("should let the options of the call override the ones of the schema", func() {
+			// arrange
+			formSchema := schema
+			formSchema.Options = []validator.Option{validator.WithCoercion(validator.CoercionForm)}
+			value := map[string]any{"name": "John", "age": "42"}
+
+			// act
+			_, err := formSchema.Validate(value, validator.WithCoercion(validator.CoercionStrict))
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("age: value is not a number"))
+		})
+	})
+}
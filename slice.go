@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"fmt"
+)
+
+func ValidateMapSlice[T, R any](
+	name string,
+	value map[string]any,
+	validate func(value any, rules R, opts ...Option) (T, error),
+	itemRules R,
+	rules []Rule[[]T],
+	opts ...Option,
+) ([]T, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, missingKeyError(name)
+	}
+	return ValidateSlice(rawValue, validate, itemRules, rules, opts...)
+}
+
+// ValidateSlice validates every item of a []any or a []T with validate and
+// itemRules, then the whole slice with rules, e.g. ValidateSlice(value,
+// ValidateString, StringValidators{StringEmailValidator{}}, nil).
+func ValidateSlice[T, R any](
+	value any,
+	validate func(value any, rules R, opts ...Option) (T, error),
+	itemRules R,
+	rules []Rule[[]T],
+	opts ...Option,
+) ([]T, error) {
+	var items []any
+
+	switch value := value.(type) {
+	case []any:
+		items = value
+	case []T:
+		items = make([]any, 0, len(value))
+		for _, item := range value {
+			items = append(items, item)
+		}
+	default:
+		return nil, typeError("value is not a list")
+	}
+
+	sliceValue := make([]T, 0, len(items))
+	for i, item := range items {
+		val, err := validate(item, itemRules, opts...)
+		if err != nil {
+			if IsLookupError(err) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("item %v: %w", i, err)
+		}
+		sliceValue = append(sliceValue, val)
+	}
+
+	if err := applyRules(newOptions(opts).context(), rules, sliceValue); err != nil {
+		return nil, err
+	}
+
+	return sliceValue, nil
+}
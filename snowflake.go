@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+var (
+	TwitterEpoch = time.UnixMilli(1288834974657).UTC()
+	DiscordEpoch = time.UnixMilli(1420070400000).UTC()
+)
+
+// WithSnowflakeEpoch sets the epoch of Snowflake timestamps, TwitterEpoch by default.
+func WithSnowflakeEpoch(epoch time.Time) Option {
+	return func(o *options) {
+		o.snowflakeEpoch = epoch
+	}
+}
+
+// Snowflake is a 63 bits identifier made of a 41 bits timestamp in
+// milliseconds since an epoch, a 10 bits node and a 12 bits sequence.
+type Snowflake struct {
+	ID       int64
+	Time     time.Time
+	Node     int64
+	Sequence int64
+}
+
+func (s Snowflake) String() string {
+	return strconv.FormatInt(s.ID, 10)
+}
+
+func ValidateMapSnowflake(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	opts ...Option,
+) (Snowflake, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return Snowflake{}, err
+	}
+	if !ok {
+		return Snowflake{}, missingKeyError(name)
+	}
+	return ValidateSnowflake(rawValue, rules, opts...)
+}
+
+func ValidateMapSnowflakeOrNil(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	opts ...Option,
+) (*Snowflake, error) {
+	return validateMapOrNil(name, value, ValidateSnowflake, rules, opts)
+}
+
+func ValidateMapSnowflakeOrDefault(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue Snowflake,
+	opts ...Option,
+) (Snowflake, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateSnowflake, rules, func() Snowflake {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapSnowflakeOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue func() Snowflake,
+	opts ...Option,
+) (Snowflake, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateSnowflake, rules, defaultValue, opts)
+}
+
+// ValidateSnowflake accepts a positive integer or its decimal string. Floats
+// are only accepted below 2^53, as larger IDs lose precision in JSON numbers.
+func ValidateSnowflake(value any, rules TimeValidators, opts ...Option) (Snowflake, error) {
+	o := newOptions(opts)
+
+	var id int64
+
+	switch value := value.(type) {
+	case Snowflake:
+		id = value.ID
+	case int:
+		id = int64(value)
+	case int64:
+		id = value
+	case uint64:
+		if value > math.MaxInt64 {
+			return Snowflake{}, typeError("value is not a snowflake")
+		}
+		id = int64(value)
+	case float64:
+		if value != math.Trunc(value) || value >= 1<<53 {
+			return Snowflake{}, typeError("value is not a snowflake")
+		}
+		id = int64(value)
+	case string:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return Snowflake{}, typeError("value is not a snowflake")
+		}
+		id = parsed
+	default:
+		return Snowflake{}, typeError("value is not a snowflake")
+	}
+
+	if id <= 0 {
+		return Snowflake{}, typeError("value is not a snowflake")
+	}
+
+	snowflakeValue := Snowflake{
+		ID:       id,
+		Time:     o.snowflakeEpoch.Add(time.Duration(id>>22) * time.Millisecond),
+		Node:     (id >> 12) & 0x3ff,
+		Sequence: id & 0xfff,
+	}
+
+	if _, err := ValidateTime(snowflakeValue.Time, rules, opts...); err != nil {
+		return Snowflake{}, err
+	}
+
+	if err := checkTimestamp(snowflakeValue.Time, o.snowflakeEpoch, o); err != nil {
+		return Snowflake{}, err
+	}
+
+	return snowflakeValue, nil
+}
@@ -0,0 +1,102 @@
+package validator_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func snowflakeValidatorTests() {
+	Describe("ValidateSnowflake", func() {
+		DescribeTable("should return a Snowflake with its creation time",
+			func(value any) {
+				// act
+				result, err := validator.ValidateSnowflake(
+					value,
+					validator.TimeValidators{},
+					validator.WithSnowflakeEpoch(validator.DiscordEpoch),
+				)
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(validator.Snowflake{
+					ID:       175928847299117063,
+					Time:     time.Date(2016, 4, 30, 11, 18, 25, 796000000, time.UTC),
+					Node:     32,
+					Sequence: 7,
+				}))
+			},
+			Entry("from a string", "175928847299117063"),
+			Entry("from an int64", int64(175928847299117063)),
+		)
+
+		It("should use the Twitter epoch by default", func() {
+			// arrange
+			value := "1382350606417817604"
+
+			// act
+			result, err := validator.ValidateSnowflake(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Time.Year()).To(Equal(2021))
+		})
+
+		DescribeTable("should not return a Snowflake when input is garbage",
+			func(value any) {
+				// act
+				_, err := validator.ValidateSnowflake(value, validator.TimeValidators{})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a snowflake"))
+			},
+			Entry("a bool", true),
+			Entry("a negative number", -5),
+			Entry("a non numeric string", "12ab"),
+			Entry("an imprecise float", 1.7592884729911706e17),
+		)
+
+		It("should not return a Snowflake created after max", func() {
+			// arrange
+			value := "175928847299117063"
+
+			// act
+			_, err := validator.ValidateSnowflake(value, validator.TimeValidators{
+				validator.TimeMaxValidator{Max: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)},
+			}, validator.WithSnowflakeEpoch(validator.DiscordEpoch))
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should not return a Snowflake created far in the future", func() {
+			// arrange
+			clock := validator.FixedClock{Time: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+			// act
+			_, err := validator.ValidateSnowflake("175928847299117063", validator.TimeValidators{},
+				validator.WithSnowflakeEpoch(validator.DiscordEpoch), validator.WithClock(clock))
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value has an implausible timestamp"))
+		})
+
+		It("should allow a wider skew", func() {
+			// arrange
+			clock := validator.FixedClock{Time: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+			// act
+			_, err := validator.ValidateSnowflake("175928847299117063", validator.TimeValidators{},
+				validator.WithSnowflakeEpoch(validator.DiscordEpoch), validator.WithClock(clock),
+				validator.WithTimestampSkew(365*24*time.Hour))
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+}
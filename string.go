@@ -1,7 +1,6 @@
 package validator
 
 import (
-	"errors"
 	"fmt"
 	"net/mail"
 	"strconv"
@@ -27,6 +26,14 @@ func (v StringMaxValidator) Validate(value string) error {
 	return nil
 }
 
+func (v StringMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "string.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("at most %v characters", v.Max),
+	}
+}
+
 type StringMinValidator struct {
 	Min int
 }
@@ -38,6 +45,14 @@ func (v StringMinValidator) Validate(value string) error {
 	return nil
 }
 
+func (v StringMinValidator) Describe() Description {
+	return Description{
+		Kind:    "string.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("at least %v characters", v.Min),
+	}
+}
+
 type StringEmailValidator struct{}
 
 func (v StringEmailValidator) Validate(value string) error {
@@ -47,6 +62,10 @@ func (v StringEmailValidator) Validate(value string) error {
 	return nil
 }
 
+func (v StringEmailValidator) Describe() Description {
+	return Description{Kind: "string.email", Summary: "must be an email"}
+}
+
 type StringPhoneValidator struct{}
 
 func (v StringPhoneValidator) Validate(value string) error {
@@ -56,35 +75,69 @@ func (v StringPhoneValidator) Validate(value string) error {
 	return nil
 }
 
-func ValidateMapString(name string, value map[string]any, rules StringValidators) (string, error) {
-	rawValue, ok := value[name]
+func (v StringPhoneValidator) Describe() Description {
+	return Description{Kind: "string.phone", Summary: "must be an international phone number"}
+}
+
+func ValidateMapString(
+	name string,
+	value map[string]any,
+	rules StringValidators,
+	opts ...Option,
+) (string, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return "", err
+	}
 	if !ok {
-		return "", fmt.Errorf("missing key \"%v\"", name)
+		return "", missingKeyError(name)
 	}
-	return ValidateString(rawValue, rules)
+	return ValidateString(rawValue, rules, opts...)
 }
 
 func ValidateMapStringOrNil(
 	name string,
 	value map[string]any,
 	rules StringValidators,
+	opts ...Option,
 ) (*string, error) {
-	rawValue, ok := value[name]
-	if !ok {
-		return nil, nil
-	}
+	return validateMapOrNil(name, value, ValidateString, rules, opts)
+}
 
-	val, err := ValidateString(rawValue, rules)
+func ValidateMapStringOrDefault(
+	name string,
+	value map[string]any,
+	rules StringValidators,
+	defaultValue string,
+	opts ...Option,
+) (string, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateString, rules, func() string {
+		return defaultValue
+	}, opts)
+}
 
-	return &val, err
+func ValidateMapStringOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules StringValidators,
+	defaultValue func() string,
+	opts ...Option,
+) (string, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateString, rules, defaultValue, opts)
 }
 
-func ValidateString(value any, rules StringValidators) (string, error) {
+func ValidateString(value any, rules StringValidators, opts ...Option) (string, error) {
+	o := newOptions(opts)
+
 	stringValue, stringOk := value.(string)
 	intValue, intOk := value.(int)
 	floatValue, floatOk := value.(float64)
 	if !stringOk && !intOk && !floatOk {
-		return "", errors.New("value is not a string")
+		return "", typeError("value is not a string")
+	}
+
+	if (intOk || floatOk) && !o.numberToString() {
+		return "", typeError("value is not a string")
 	}
 
 	if intOk {
@@ -95,10 +148,8 @@ func ValidateString(value any, rules StringValidators) (string, error) {
 		stringValue = strconv.FormatFloat(floatValue, 'f', -1, 64)
 	}
 
-	for _, rule := range rules {
-		if err := rule.Validate(stringValue); err != nil {
-			return "", err
-		}
+	if err := applyRules(o.context(), rules, stringValue); err != nil {
+		return "", err
 	}
 
 	return stringValue, nil
@@ -1,11 +1,67 @@
 package validator
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 )
 
+const (
+	DateLayout     = time.DateOnly
+	DateTimeLayout = "2006-01-02T15:04:05"
+	ISO8601Layout  = "2006-01-02T15:04:05Z0700"
+)
+
+var DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	ISO8601Layout,
+	DateTimeLayout,
+	time.DateTime,
+	DateLayout,
+}
+
+type EpochUnit int
+
+const (
+	EpochNone EpochUnit = iota
+	EpochSeconds
+	EpochMilliseconds
+)
+
+// WithTimeLayouts replaces the layouts tried, in order, when a time is given as a string.
+func WithTimeLayouts(layouts ...string) Option {
+	return func(o *options) {
+		o.timeLayouts = layouts
+	}
+}
+
+// WithLocation sets the location of times parsed from a layout without a zone
+// offset, UTC when location is nil.
+func WithLocation(location *time.Location) Option {
+	return func(o *options) {
+		if location == nil {
+			location = time.UTC
+		}
+		o.location = location
+	}
+}
+
+// WithUTC converts every validated time to UTC.
+func WithUTC() Option {
+	return func(o *options) {
+		o.utc = true
+	}
+}
+
+// WithEpoch accepts numbers and numeric strings as Unix timestamps in the given unit.
+func WithEpoch(unit EpochUnit) Option {
+	return func(o *options) {
+		o.epoch = unit
+	}
+}
+
 type TimeValidators []timeValidator
 
 type timeValidator interface {
@@ -23,6 +79,14 @@ func (v TimeMaxValidator) Validate(value time.Time) error {
 	return nil
 }
 
+func (v TimeMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "time.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("at or before %v", v.Max.Format(time.RFC3339)),
+	}
+}
+
 type TimeMinValidator struct {
 	Min time.Time
 }
@@ -34,49 +98,289 @@ func (v TimeMinValidator) Validate(value time.Time) error {
 	return nil
 }
 
-func ValidateMapTime(name string, value map[string]any, rules TimeValidators) (time.Time, error) {
-	rawValue, ok := value[name]
+func (v TimeMinValidator) Describe() Description {
+	return Description{
+		Kind:    "time.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("at or after %v", v.Min.Format(time.RFC3339)),
+	}
+}
+
+type TimePastValidator struct {
+	Clock Clock
+}
+
+func (v TimePastValidator) Validate(value time.Time) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v TimePastValidator) Describe() Description {
+	return Description{Kind: "time.past", Summary: "in the past"}
+}
+
+func (v TimePastValidator) ValidateContext(ctx context.Context, value time.Time) error {
+	if !value.Before(now(ctx, v.Clock)) {
+		return fmt.Errorf("value must be in the past")
+	}
+	return nil
+}
+
+type TimeFutureValidator struct {
+	Clock Clock
+}
+
+func (v TimeFutureValidator) Validate(value time.Time) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v TimeFutureValidator) Describe() Description {
+	return Description{Kind: "time.future", Summary: "in the future"}
+}
+
+func (v TimeFutureValidator) ValidateContext(ctx context.Context, value time.Time) error {
+	if !value.After(now(ctx, v.Clock)) {
+		return fmt.Errorf("value must be in the future")
+	}
+	return nil
+}
+
+type TimeWithinValidator struct {
+	Duration time.Duration
+	Clock    Clock
+}
+
+func (v TimeWithinValidator) Validate(value time.Time) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v TimeWithinValidator) Describe() Description {
+	return Description{
+		Kind:    "time.within",
+		Params:  map[string]any{"duration": v.Duration},
+		Summary: fmt.Sprintf("within %v of now", v.Duration),
+	}
+}
+
+func (v TimeWithinValidator) ValidateContext(ctx context.Context, value time.Time) error {
+	current := now(ctx, v.Clock)
+	if value.Before(current.Add(-v.Duration)) || value.After(current.Add(v.Duration)) {
+		return fmt.Errorf("value must be within %v of now", v.Duration)
+	}
+	return nil
+}
+
+type TimeNotOlderThanValidator struct {
+	Age   time.Duration
+	Clock Clock
+}
+
+func (v TimeNotOlderThanValidator) Validate(value time.Time) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v TimeNotOlderThanValidator) Describe() Description {
+	return Description{
+		Kind:    "time.not_older_than",
+		Params:  map[string]any{"age": v.Age},
+		Summary: fmt.Sprintf("not older than %v", v.Age),
+	}
+}
+
+func (v TimeNotOlderThanValidator) ValidateContext(ctx context.Context, value time.Time) error {
+	if value.Before(now(ctx, v.Clock).Add(-v.Age)) {
+		return fmt.Errorf("value must not be older than %v", v.Age)
+	}
+	return nil
+}
+
+// TimeMinAgeValidator compares calendar dates in the location of the value,
+// so a birthday counts from midnight whatever the time of day of the clock.
+type TimeMinAgeValidator struct {
+	Years int
+	Clock Clock
+}
+
+func (v TimeMinAgeValidator) Validate(value time.Time) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+func (v TimeMinAgeValidator) Describe() Description {
+	return Description{
+		Kind:    "time.min_age",
+		Params:  map[string]any{"years": v.Years},
+		Summary: fmt.Sprintf("at least %v years ago", v.Years),
+	}
+}
+
+func (v TimeMinAgeValidator) ValidateContext(ctx context.Context, value time.Time) error {
+	if yearsBetween(value, now(ctx, v.Clock)) < v.Years {
+		return fmt.Errorf("value must be at least %v years ago", v.Years)
+	}
+	return nil
+}
+
+func yearsBetween(from time.Time, to time.Time) int {
+	to = to.In(from.Location())
+
+	years := to.Year() - from.Year()
+	if to.Month() < from.Month() || (to.Month() == from.Month() && to.Day() < from.Day()) {
+		years--
+	}
+	return years
+}
+
+func ValidateMapTime(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	opts ...Option,
+) (time.Time, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return time.Time{}, err
+	}
 	if !ok {
-		return time.Time{}, fmt.Errorf("missing key \"%v\"", name)
+		return time.Time{}, missingKeyError(name)
 	}
-	return ValidateTime(rawValue, rules)
+	return ValidateTime(rawValue, rules, opts...)
 }
 
 func ValidateMapTimeOrNil(
 	name string,
 	value map[string]any,
 	rules TimeValidators,
+	opts ...Option,
 ) (*time.Time, error) {
-	rawValue, ok := value[name]
-	if !ok {
-		return nil, nil
-	}
+	return validateMapOrNil(name, value, ValidateTime, rules, opts)
+}
 
-	val, err := ValidateTime(rawValue, rules)
+func ValidateMapTimeOrDefault(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue time.Time,
+	opts ...Option,
+) (time.Time, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateTime, rules, func() time.Time {
+		return defaultValue
+	}, opts)
+}
 
-	return &val, err
+func ValidateMapTimeOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue func() time.Time,
+	opts ...Option,
+) (time.Time, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateTime, rules, defaultValue, opts)
 }
 
-func ValidateTime(value any, rules TimeValidators) (time.Time, error) {
-	timeValue, timeOk := value.(time.Time)
-	stringValue, stringOk := value.(string)
-	if !timeOk && !stringOk {
-		return time.Time{}, errors.New("value is not a time")
+func ValidateTime(value any, rules TimeValidators, opts ...Option) (time.Time, error) {
+	o := newOptions(opts)
+
+	timeValue, err := parseTime(value, o)
+	if err != nil {
+		return time.Time{}, err
 	}
 
-	if stringOk {
-		date, err := time.Parse("2006-01-02", stringValue)
-		if err != nil {
-			return time.Time{}, errors.New("value is not a time")
-		}
-		timeValue = date
+	if o.utc {
+		timeValue = timeValue.UTC()
 	}
 
-	for _, rule := range rules {
-		if err := rule.Validate(timeValue); err != nil {
-			return time.Time{}, err
-		}
+	if err := applyRules(o.context(), rules, timeValue); err != nil {
+		return time.Time{}, err
 	}
 
 	return timeValue, nil
 }
+
+// timeMessage is a time such as a *timestamppb.Timestamp.
+type timeMessage interface {
+	AsTime() time.Time
+	IsValid() bool
+}
+
+func parseTime(value any, o options) (time.Time, error) {
+	switch value := value.(type) {
+	case time.Time:
+		return value, nil
+	case timeMessage:
+		if value.IsValid() {
+			return value.AsTime(), nil
+		}
+	case string:
+		if !o.stringToTime() {
+			break
+		}
+		for _, layout := range o.timeLayouts {
+			date, err := time.ParseInLocation(layout, value, o.location)
+			if err == nil {
+				return date, nil
+			}
+		}
+		if o.epochUnit() != EpochNone {
+			if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+				return epochTime(number, o)
+			}
+			if number, err := strconv.ParseFloat(value, 64); err == nil {
+				return parseEpoch(number, o)
+			}
+		}
+	case int:
+		if o.epochUnit() != EpochNone {
+			return epochTime(int64(value), o)
+		}
+	case int64:
+		if o.epochUnit() != EpochNone {
+			return epochTime(value, o)
+		}
+	case float64:
+		if o.epochUnit() != EpochNone {
+			return parseEpoch(value, o)
+		}
+	}
+	return time.Time{}, typeError("value is not a time")
+}
+
+// Epochs are only read between the years 1 and 9999, the range of the time
+// layouts, as larger numbers overflow the conversions of time.Unix.
+const (
+	minEpochSeconds = -62135596800
+	maxEpochSeconds = 253402300799
+)
+
+func epochTime(number int64, o options) (time.Time, error) {
+	seconds := number
+	if o.epochUnit() == EpochMilliseconds {
+		seconds = number / 1000
+	}
+	if seconds < minEpochSeconds || seconds > maxEpochSeconds {
+		return time.Time{}, typeError("value is not a time")
+	}
+
+	if o.epochUnit() == EpochMilliseconds {
+		return time.UnixMilli(number).In(o.location), nil
+	}
+	return time.Unix(number, 0).In(o.location), nil
+}
+
+func parseEpoch(number float64, o options) (time.Time, error) {
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return time.Time{}, typeError("value is not a time")
+	}
+
+	if number == math.Trunc(number) && math.Abs(number) < 1<<53 {
+		return epochTime(int64(number), o)
+	}
+
+	if o.epochUnit() == EpochMilliseconds {
+		number /= 1000
+	}
+	if number < minEpochSeconds || number > maxEpochSeconds {
+		return time.Time{}, typeError("value is not a time")
+	}
+
+	seconds, fraction := math.Modf(number)
+	return time.Unix(int64(seconds), int64(math.Round(fraction*1e9))).In(o.location), nil
+}
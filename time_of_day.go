@@ -0,0 +1,260 @@
+package validator
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimeOfDay is a wall clock time such as 14:30, without a date or a location.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{
+		Hour:       t.Hour(),
+		Minute:     t.Minute(),
+		Second:     t.Second(),
+		Nanosecond: t.Nanosecond(),
+	}
+}
+
+// ParseTimeOfDay reads "15:04" or "15:04:05" with optional fractional seconds.
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	t, err := time.Parse(time.TimeOnly, value)
+	if err != nil {
+		t, err = time.Parse("15:04", value)
+	}
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayOf(t), nil
+}
+
+// IsValid reports whether every component is within its range, from 00:00:00
+// to 23:59:59.999999999.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < int(time.Second)
+}
+
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond), "0")
+	}
+	return s
+}
+
+// On returns the instant at which date reaches this time of day in loc.
+func (t TimeOfDay) On(date Date, loc *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	switch {
+	case t.Hour != other.Hour:
+		return compareInts(t.Hour, other.Hour)
+	case t.Minute != other.Minute:
+		return compareInts(t.Minute, other.Minute)
+	case t.Second != other.Second:
+		return compareInts(t.Second, other.Second)
+	default:
+		return compareInts(t.Nanosecond, other.Nanosecond)
+	}
+}
+
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.Compare(other) < 0
+}
+
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.Compare(other) > 0
+}
+
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *TimeOfDay) UnmarshalText(data []byte) error {
+	timeOfDay, err := ParseTimeOfDay(string(data))
+	if err != nil {
+		return err
+	}
+	*t = timeOfDay
+	return nil
+}
+
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+func (t *TimeOfDay) Scan(src any) error {
+	switch src := src.(type) {
+	case time.Time:
+		*t = TimeOfDayOf(src)
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(src))
+	case []byte:
+		return t.UnmarshalText(src)
+	}
+	return fmt.Errorf("cannot scan %T into a TimeOfDay", src)
+}
+
+type TimeOfDayValidators []timeOfDayValidator
+
+type timeOfDayValidator interface {
+	Validate(value TimeOfDay) error
+}
+
+type TimeOfDayMaxValidator struct {
+	Max TimeOfDay
+}
+
+func (v TimeOfDayMaxValidator) Validate(value TimeOfDay) error {
+	if value.After(v.Max) {
+		return fmt.Errorf("value must not be after %v", v.Max)
+	}
+	return nil
+}
+
+func (v TimeOfDayMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "time_of_day.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("at or before %v", v.Max),
+	}
+}
+
+type TimeOfDayMinValidator struct {
+	Min TimeOfDay
+}
+
+func (v TimeOfDayMinValidator) Validate(value TimeOfDay) error {
+	if value.Before(v.Min) {
+		return fmt.Errorf("value must not be before %v", v.Min)
+	}
+	return nil
+}
+
+func (v TimeOfDayMinValidator) Describe() Description {
+	return Description{
+		Kind:    "time_of_day.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("at or after %v", v.Min),
+	}
+}
+
+// TimeOfDayRangeValidator accepts times from From to To included, such as
+// business hours. A range whose From is after its To wraps around midnight.
+type TimeOfDayRangeValidator struct {
+	From TimeOfDay
+	To   TimeOfDay
+}
+
+func (v TimeOfDayRangeValidator) Validate(value TimeOfDay) error {
+	var inRange bool
+	if v.From.After(v.To) {
+		inRange = !value.Before(v.From) || !value.After(v.To)
+	} else {
+		inRange = !value.Before(v.From) && !value.After(v.To)
+	}
+
+	if !inRange {
+		return fmt.Errorf("value must be between %v and %v", v.From, v.To)
+	}
+	return nil
+}
+
+func (v TimeOfDayRangeValidator) Describe() Description {
+	return Description{
+		Kind:    "time_of_day.range",
+		Params:  map[string]any{"from": v.From, "to": v.To},
+		Summary: fmt.Sprintf("between %v and %v", v.From, v.To),
+	}
+}
+
+func ValidateMapTimeOfDay(
+	name string,
+	value map[string]any,
+	rules TimeOfDayValidators,
+	opts ...Option,
+) (TimeOfDay, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	if !ok {
+		return TimeOfDay{}, missingKeyError(name)
+	}
+	return ValidateTimeOfDay(rawValue, rules, opts...)
+}
+
+func ValidateMapTimeOfDayOrNil(
+	name string,
+	value map[string]any,
+	rules TimeOfDayValidators,
+	opts ...Option,
+) (*TimeOfDay, error) {
+	return validateMapOrNil(name, value, ValidateTimeOfDay, rules, opts)
+}
+
+func ValidateMapTimeOfDayOrDefault(
+	name string,
+	value map[string]any,
+	rules TimeOfDayValidators,
+	defaultValue TimeOfDay,
+	opts ...Option,
+) (TimeOfDay, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateTimeOfDay, rules, func() TimeOfDay {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapTimeOfDayOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules TimeOfDayValidators,
+	defaultValue func() TimeOfDay,
+	opts ...Option,
+) (TimeOfDay, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateTimeOfDay, rules, defaultValue, opts)
+}
+
+func ValidateTimeOfDay(value any, rules TimeOfDayValidators, opts ...Option) (TimeOfDay, error) {
+	o := newOptions(opts)
+
+	var timeOfDayValue TimeOfDay
+
+	switch value := value.(type) {
+	case TimeOfDay:
+		if !value.IsValid() {
+			return TimeOfDay{}, typeError("value is not a time of day")
+		}
+		timeOfDayValue = value
+	case time.Time:
+		timeOfDayValue = TimeOfDayOf(value)
+	case string:
+		timeOfDay, err := ParseTimeOfDay(value)
+		if err != nil || !o.stringToTime() {
+			return TimeOfDay{}, typeError("value is not a time of day")
+		}
+		timeOfDayValue = timeOfDay
+	default:
+		return TimeOfDay{}, typeError("value is not a time of day")
+	}
+
+	if err := applyRules(o.context(), rules, timeOfDayValue); err != nil {
+		return TimeOfDay{}, err
+	}
+
+	return timeOfDayValue, nil
+}
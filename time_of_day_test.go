@@ -0,0 +1,126 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func timeOfDayValidatorTests() {
+	Describe("ValidateTimeOfDay", func() {
+		DescribeTable("should return a TimeOfDay",
+			func(value any, expected validator.TimeOfDay) {
+				// act
+				result, err := validator.ValidateTimeOfDay(value, validator.TimeOfDayValidators{})
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(expected))
+			},
+			Entry("from hours and minutes", "14:30", validator.TimeOfDay{Hour: 14, Minute: 30}),
+			Entry("from hours, minutes and seconds", "14:30:15",
+				validator.TimeOfDay{Hour: 14, Minute: 30, Second: 15}),
+			Entry("from fractional seconds", "14:30:15.25",
+				validator.TimeOfDay{Hour: 14, Minute: 30, Second: 15, Nanosecond: 250000000}),
+			Entry("from a Time", time.Date(2024, 5, 3, 14, 30, 0, 0, time.UTC),
+				validator.TimeOfDay{Hour: 14, Minute: 30}),
+		)
+
+		DescribeTable("should not return a TimeOfDay when input is garbage",
+			func(value any) {
+				// act
+				_, err := validator.ValidateTimeOfDay(value, validator.TimeOfDayValidators{})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a time of day"))
+			},
+			Entry("an int", 1430),
+			Entry("an out of range hour", "25:00"),
+			Entry("an out of range TimeOfDay", validator.TimeOfDay{Hour: 25, Minute: 99}),
+			Entry("a negative TimeOfDay", validator.TimeOfDay{Second: -1}),
+			Entry("a date", "2024-05-03"),
+		)
+
+		DescribeTable("TimeOfDayRangeValidator",
+			func(value string, from string, to string, valid bool) {
+				// arrange
+				start, _ := validator.ParseTimeOfDay(from)
+				end, _ := validator.ParseTimeOfDay(to)
+
+				// act
+				_, err := validator.ValidateTimeOfDay(value, validator.TimeOfDayValidators{
+					validator.TimeOfDayRangeValidator{From: start, To: end},
+				})
+
+				// assert
+				if valid {
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(Equal("value must be between " + start.String() + " and " + end.String()))
+				}
+			},
+			Entry("during business hours", "14:30", "09:00", "17:00", true),
+			Entry("at opening", "09:00", "09:00", "17:00", true),
+			Entry("after closing", "17:01", "09:00", "17:00", false),
+			Entry("during a night shift", "02:00", "22:00", "06:00", true),
+			Entry("outside a night shift", "12:00", "22:00", "06:00", false),
+		)
+
+		It("should not return a TimeOfDay when it is before min", func() {
+			// arrange
+			value := "08:59"
+
+			// act
+			_, err := validator.ValidateTimeOfDay(value, validator.TimeOfDayValidators{
+				validator.TimeOfDayMinValidator{Min: validator.TimeOfDay{Hour: 9}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be before 09:00:00"))
+		})
+
+		It("should not return a TimeOfDay when it is after max", func() {
+			// arrange
+			value := "17:00:01"
+
+			// act
+			_, err := validator.ValidateTimeOfDay(value, validator.TimeOfDayValidators{
+				validator.TimeOfDayMaxValidator{Max: validator.TimeOfDay{Hour: 17}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be after 17:00:00"))
+		})
+	})
+
+	Describe("TimeOfDay", func() {
+		It("should encode JSON as a string", func() {
+			// act
+			data, err := json.Marshal(validator.TimeOfDay{Hour: 14, Minute: 30, Nanosecond: 500000000})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`"14:30:00.5"`))
+		})
+
+		It("should scan a SQL string", func() {
+			// arrange
+			var timeOfDay validator.TimeOfDay
+
+			// act
+			err := timeOfDay.Scan([]byte("14:30:00"))
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(timeOfDay).To(Equal(validator.TimeOfDay{Hour: 14, Minute: 30}))
+		})
+	})
+}
@@ -1,11 +1,14 @@
 package validator_test
 
 import (
+	"context"
 	"errors"
+	"math"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/gungun974/validator"
 )
@@ -24,6 +27,31 @@ func (v FakeErrorTimeValidator) Validate(_ time.Time) error {
 
 func timeValidatorTests() {
 	Describe("ValidateTime", func() {
+		It("should return a Time from a protobuf Timestamp", func() {
+			// arrange
+			expected := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+			value := timestamppb.New(expected)
+
+			// act
+			result, err := validator.ValidateTime(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		})
+
+		It("should not return a Time from a nil protobuf Timestamp", func() {
+			// arrange
+			var value *timestamppb.Timestamp
+
+			// act
+			_, err := validator.ValidateTime(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a time"))
+		})
+
 		It("should return a Time", func() {
 			// arrange
 			value := time.Date(2023, 12, 24, 5, 0, 0, 0, time.UTC)
@@ -64,6 +92,165 @@ func timeValidatorTests() {
 			))
 		})
 
+		It("should return a Time when value is an RFC 3339 string with an offset", func() {
+			// arrange
+			value := "2023-12-24T05:30:00+02:00"
+
+			// act
+			result, err := validator.ValidateTime(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Equal(time.Date(2023, 12, 24, 3, 30, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should return a Time when value is an ISO 8601 string without colon in offset", func() {
+			// arrange
+			value := "2023-12-24T05:30:00+0200"
+
+			// act
+			result, err := validator.ValidateTime(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Equal(time.Date(2023, 12, 24, 3, 30, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should return a Time when value is a date-time string without offset", func() {
+			// arrange
+			value := "2023-12-24 05:30:00"
+
+			// act
+			result, err := validator.ValidateTime(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(
+				time.Date(2023, 12, 24, 5, 30, 0, 0, time.UTC),
+			))
+		})
+
+		It("should only accept the given layouts", func() {
+			// arrange
+			value := "2023-12-24"
+
+			// act
+			_, err := validator.ValidateTime(
+				value,
+				validator.TimeValidators{},
+				validator.WithTimeLayouts(time.RFC3339),
+			)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a time"))
+		})
+
+		It("should return a Time when value matches a custom layout", func() {
+			// arrange
+			value := "24/12/2023"
+
+			// act
+			result, err := validator.ValidateTime(
+				value,
+				validator.TimeValidators{},
+				validator.WithTimeLayouts("02/01/2006"),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(
+				time.Date(2023, 12, 24, 0, 0, 0, 0, time.UTC),
+			))
+		})
+
+		It("should parse a date-only string in the given location", func() {
+			// arrange
+			value := "2023-12-24"
+			location := time.FixedZone("UTC+9", 9*60*60)
+
+			// act
+			result, err := validator.ValidateTime(
+				value,
+				validator.TimeValidators{},
+				validator.WithLocation(location),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(
+				time.Date(2023, 12, 24, 0, 0, 0, 0, location),
+			))
+		})
+
+		It("should parse in UTC with a nil location", func() {
+			// act
+			result, err := validator.ValidateTime("2024-01-01", validator.TimeValidators{}, validator.WithLocation(nil))
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should normalize the Time to UTC", func() {
+			// arrange
+			value := time.Date(2023, 12, 24, 5, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+
+			// act
+			result, err := validator.ValidateTime(
+				value,
+				validator.TimeValidators{},
+				validator.WithUTC(),
+			)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(
+				time.Date(2023, 12, 24, 3, 0, 0, 0, time.UTC),
+			))
+		})
+
+		DescribeTable("should return a Time when value is an epoch",
+			func(value any, unit validator.EpochUnit, expected time.Time) {
+				// act
+				result, err := validator.ValidateTime(
+					value,
+					validator.TimeValidators{},
+					validator.WithEpoch(unit),
+				)
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(expected))
+			},
+			Entry("int seconds", 1703394000, validator.EpochSeconds,
+				time.Date(2023, 12, 24, 5, 0, 0, 0, time.UTC)),
+			Entry("float seconds", 1703394000.5, validator.EpochSeconds,
+				time.Date(2023, 12, 24, 5, 0, 0, 500000000, time.UTC)),
+			Entry("string seconds", "1703394000", validator.EpochSeconds,
+				time.Date(2023, 12, 24, 5, 0, 0, 0, time.UTC)),
+			Entry("int milliseconds", 1703394000123, validator.EpochMilliseconds,
+				time.Date(2023, 12, 24, 5, 0, 0, 123000000, time.UTC)),
+			Entry("float milliseconds", float64(1703394000123), validator.EpochMilliseconds,
+				time.Date(2023, 12, 24, 5, 0, 0, 123000000, time.UTC)),
+		)
+
+		DescribeTable("should not return a Time when the epoch is out of range",
+			func(value any, unit validator.EpochUnit) {
+				// act
+				_, err := validator.ValidateTime(value, validator.TimeValidators{}, validator.WithEpoch(unit))
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a time"))
+			},
+			Entry("a huge float", 1e300, validator.EpochSeconds),
+			Entry("a huge negative float", -1e300, validator.EpochMilliseconds),
+			Entry("an overflowing string", "99999999999999999999", validator.EpochSeconds),
+			Entry("an int64 past the year 9999", int64(math.MaxInt64), validator.EpochSeconds),
+			Entry("an int past the year 9999", 253402300800, validator.EpochSeconds),
+		)
+
 		It("should return a Time when all rules are satisfied", func() {
 			// arrange
 			value := time.Date(2023, 12, 24, 5, 0, 0, 0, time.UTC)
@@ -129,5 +316,132 @@ func timeValidatorTests() {
 				time.Date(2023, 12, 25, 5, 0, 0, 0, time.UTC),
 			))
 		})
+		Describe("Relative rules", func() {
+			now := time.Date(2023, 12, 24, 12, 0, 0, 0, time.UTC)
+			clock := validator.FixedClock{Time: now}
+
+			It("should return a Time when date is in the past", func() {
+				// arrange
+				value := now.Add(-time.Hour)
+
+				// act
+				result, err := validator.ValidateTime(value, validator.TimeValidators{
+					validator.TimePastValidator{},
+				}, validator.WithClock(clock))
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(value))
+			})
+
+			It("should not return a Time when date is not in the past", func() {
+				// arrange
+				value := now
+
+				// act
+				_, err := validator.ValidateTime(value, validator.TimeValidators{
+					validator.TimePastValidator{},
+				}, validator.WithClock(clock))
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value must be in the past"))
+			})
+
+			It("should not return a Time when date is not in the future", func() {
+				// arrange
+				value := now.Add(-time.Second)
+
+				// act
+				_, err := validator.ValidateTime(value, validator.TimeValidators{
+					validator.TimeFutureValidator{},
+				}, validator.WithClock(clock))
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value must be in the future"))
+			})
+
+			It("should prefer the Clock of the rule over the Clock of the options", func() {
+				// arrange
+				value := now.Add(time.Hour)
+
+				// act
+				result, err := validator.ValidateTime(value, validator.TimeValidators{
+					validator.TimeFutureValidator{Clock: validator.FixedClock{Time: now}},
+				}, validator.WithClock(validator.FixedClock{Time: now.Add(2 * time.Hour)}))
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).To(Equal(value))
+			})
+
+			It("should read the Clock from the context", func() {
+				// arrange
+				ctx := validator.ContextWithClock(context.Background(), clock)
+
+				// act
+				err := validator.TimeFutureValidator{}.ValidateContext(ctx, now.Add(time.Minute))
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			DescribeTable("TimeWithinValidator",
+				func(value time.Time, valid bool) {
+					// act
+					_, err := validator.ValidateTime(value, validator.TimeValidators{
+						validator.TimeWithinValidator{Duration: 30 * 24 * time.Hour},
+					}, validator.WithClock(clock))
+
+					// assert
+					if valid {
+						Expect(err).ShouldNot(HaveOccurred())
+					} else {
+						Expect(err).Should(HaveOccurred())
+						Expect(err.Error()).To(Equal("value must be within 720h0m0s of now"))
+					}
+				},
+				Entry("in 29 days", now.AddDate(0, 0, 29), true),
+				Entry("29 days ago", now.AddDate(0, 0, -29), true),
+				Entry("in 31 days", now.AddDate(0, 0, 31), false),
+				Entry("31 days ago", now.AddDate(0, 0, -31), false),
+			)
+
+			It("should not return a Time when date is older than the age", func() {
+				// arrange
+				value := now.Add(-25 * time.Hour)
+
+				// act
+				_, err := validator.ValidateTime(value, validator.TimeValidators{
+					validator.TimeNotOlderThanValidator{Age: 24 * time.Hour},
+				}, validator.WithClock(clock))
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value must not be older than 24h0m0s"))
+			})
+
+			DescribeTable("TimeMinAgeValidator",
+				func(birth time.Time, valid bool) {
+					// act
+					_, err := validator.ValidateTime(birth, validator.TimeValidators{
+						validator.TimeMinAgeValidator{Years: 18},
+					}, validator.WithClock(clock))
+
+					// assert
+					if valid {
+						Expect(err).ShouldNot(HaveOccurred())
+					} else {
+						Expect(err).Should(HaveOccurred())
+						Expect(err.Error()).To(Equal("value must be at least 18 years ago"))
+					}
+				},
+				Entry("18th birthday today", time.Date(2005, 12, 24, 23, 0, 0, 0, time.UTC), true),
+				Entry("18th birthday tomorrow", time.Date(2005, 12, 25, 0, 0, 0, 0, time.UTC), false),
+				Entry("18th birthday last month", time.Date(2005, 11, 30, 0, 0, 0, 0, time.UTC), true),
+				Entry("18th birthday next month", time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), false),
+			)
+		})
 	})
 }
@@ -0,0 +1,40 @@
+package validator
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultTimestampSkew is how far in the future the creation time of a ULID,
+// a KSUID or a Snowflake may be, to allow for clock drift between machines.
+const DefaultTimestampSkew = 24 * time.Hour
+
+// WithTimestampSkew sets how far in the future the creation time of a ULID, a
+// KSUID or a Snowflake may be, DefaultTimestampSkew by default.
+func WithTimestampSkew(skew time.Duration) Option {
+	return func(o *options) {
+		o.timestampSkew = skew
+		o.timestampCheck = true
+	}
+}
+
+// WithoutTimestampCheck accepts a ULID, a KSUID or a Snowflake whatever its
+// creation time, as when reading identifiers generated with a custom clock.
+func WithoutTimestampCheck() Option {
+	return func(o *options) {
+		o.timestampCheck = false
+	}
+}
+
+// checkTimestamp rejects the creation time of an identifier before the epoch
+// of its scheme or too far in the future, which only a corrupt or forged
+// identifier has.
+func checkTimestamp(t time.Time, epoch time.Time, o options) error {
+	if !o.timestampCheck {
+		return nil
+	}
+	if t.Before(epoch) || t.After(now(o.context(), o.clock).Add(o.timestampSkew)) {
+		return errors.New("value has an implausible timestamp")
+	}
+	return nil
+}
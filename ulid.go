@@ -0,0 +1,147 @@
+package validator
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ULID is a 128 bits identifier whose first 48 bits are its creation time in
+// Unix milliseconds.
+type ULID [16]byte
+
+func ParseULID(value string) (ULID, error) {
+	var ulid ULID
+	if len(value) != 26 || value[0] > '7' {
+		return ulid, errors.New("invalid ULID length or overflow")
+	}
+	if !decodeBase(strings.ToUpper(value), ulidAlphabet, ulid[:]) {
+		return ulid, errors.New("invalid ULID character")
+	}
+	return ulid, nil
+}
+
+func (u ULID) Time() time.Time {
+	var timestamp [8]byte
+	copy(timestamp[2:], u[:6])
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(timestamp[:]))).UTC()
+}
+
+func (u ULID) String() string {
+	return encodeBase(u[:], ulidAlphabet, 26)
+}
+
+func ValidateMapULID(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	opts ...Option,
+) (ULID, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return ULID{}, err
+	}
+	if !ok {
+		return ULID{}, missingKeyError(name)
+	}
+	return ValidateULID(rawValue, rules, opts...)
+}
+
+func ValidateMapULIDOrNil(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	opts ...Option,
+) (*ULID, error) {
+	return validateMapOrNil(name, value, ValidateULID, rules, opts)
+}
+
+func ValidateMapULIDOrDefault(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue ULID,
+	opts ...Option,
+) (ULID, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateULID, rules, func() ULID {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapULIDOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules TimeValidators,
+	defaultValue func() ULID,
+	opts ...Option,
+) (ULID, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateULID, rules, defaultValue, opts)
+}
+
+// ValidateULID checks the alphabet and length of a ULID, then its creation
+// time with rules, such as TimePastValidator to reject timestamps from the
+// future. A creation time past WithTimestampSkew is always rejected.
+func ValidateULID(value any, rules TimeValidators, opts ...Option) (ULID, error) {
+	ulidValue, ulidOk := value.(ULID)
+
+	if !ulidOk {
+		stringValue, stringOk := value.(string)
+		if !stringOk {
+			return ULID{}, typeError("value is not a ULID")
+		}
+
+		var err error
+		ulidValue, err = ParseULID(stringValue)
+		if err != nil {
+			return ULID{}, typeError("value is not a ULID")
+		}
+	}
+
+	if _, err := ValidateTime(ulidValue.Time(), rules, opts...); err != nil {
+		return ULID{}, err
+	}
+
+	if err := checkTimestamp(ulidValue.Time(), time.Unix(0, 0), newOptions(opts)); err != nil {
+		return ULID{}, err
+	}
+
+	return ulidValue, nil
+}
+
+// decodeBase reads value as a big endian number written with the digits of
+// alphabet and stores it in out, failing when it does not fit.
+func decodeBase(value string, alphabet string, out []byte) bool {
+	base := big.NewInt(int64(len(alphabet)))
+	number := new(big.Int)
+	for _, c := range value {
+		digit := strings.IndexRune(alphabet, c)
+		if digit < 0 {
+			return false
+		}
+		number.Mul(number, base)
+		number.Add(number, big.NewInt(int64(digit)))
+	}
+
+	if number.BitLen() > len(out)*8 {
+		return false
+	}
+	number.FillBytes(out)
+	return true
+}
+
+func encodeBase(data []byte, alphabet string, length int) string {
+	base := big.NewInt(int64(len(alphabet)))
+	number := new(big.Int).SetBytes(data)
+	digit := new(big.Int)
+
+	out := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		number.DivMod(number, base, digit)
+		out[i] = alphabet[digit.Int64()]
+	}
+	return string(out)
+}
@@ -0,0 +1,99 @@
+package validator_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func ulidValidatorTests() {
+	Describe("ValidateULID", func() {
+		It("should return a ULID with its creation time", func() {
+			// arrange
+			value := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+			// act
+			result, err := validator.ValidateULID(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.String()).To(Equal("01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+			Expect(result.Time()).To(Equal(time.Date(2016, 7, 30, 23, 54, 10, 259000000, time.UTC)))
+		})
+
+		It("should accept a lowercase ULID", func() {
+			// arrange
+			value := "01arz3ndektsv4rrffq69g5fav"
+
+			// act
+			result, err := validator.ValidateULID(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.String()).To(Equal("01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+		})
+
+		DescribeTable("should not return a ULID when input is garbage",
+			func(value any) {
+				// act
+				_, err := validator.ValidateULID(value, validator.TimeValidators{})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a ULID"))
+			},
+			Entry("an int", 42),
+			Entry("a short string", "01ARZ3NDEKTSV4RRFFQ69G5FA"),
+			Entry("a forbidden letter", "01ARZ3NDEKTSV4RRFFQ69G5FAU"),
+			Entry("an overflowing timestamp", "81ARZ3NDEKTSV4RRFFQ69G5FAV"),
+		)
+
+		It("should not return a ULID created in the future", func() {
+			// arrange
+			value := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+			// act
+			_, err := validator.ValidateULID(value, validator.TimeValidators{
+				validator.TimePastValidator{},
+			}, validator.WithClock(validator.FixedClock{Time: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)}))
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must be in the past"))
+		})
+
+		It("should not return a ULID with an implausible creation time", func() {
+			// arrange
+			value := "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"
+
+			// act
+			_, err := validator.ValidateULID(value, validator.TimeValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value has an implausible timestamp"))
+		})
+
+		It("should allow a creation time within the skew", func() {
+			// arrange
+			clock := validator.FixedClock{Time: time.Date(2016, 7, 30, 23, 0, 0, 0, time.UTC)}
+
+			// act
+			_, err := validator.ValidateULID("01ARZ3NDEKTSV4RRFFQ69G5FAV", validator.TimeValidators{}, validator.WithClock(clock))
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should accept any creation time without the timestamp check", func() {
+			// act
+			_, err := validator.ValidateULID("7ZZZZZZZZZZZZZZZZZZZZZZZZZ", validator.TimeValidators{}, validator.WithoutTimestampCheck())
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+}
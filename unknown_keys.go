@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// UnknownKeys tells a Schema what to do with input keys it does not declare.
+type UnknownKeys int
+
+const (
+	// UnknownKeysStrip leaves unknown keys out of the result.
+	UnknownKeysStrip UnknownKeys = iota
+	// UnknownKeysStrict reports every unknown key as an error.
+	UnknownKeysStrict
+	// UnknownKeysPassthrough copies unknown keys to the result unvalidated.
+	UnknownKeysPassthrough
+)
+
+// ValidateKnownKeys reports every key of value missing from keys, suggesting
+// the closest known key when suggest is set. Its error, when not nil, is an
+// Errors.
+func ValidateKnownKeys(value map[string]any, keys []string, suggest bool) error {
+	var errs Errors
+	for _, key := range unknownKeys(value, keys) {
+		errs = append(errs, &FieldError{Path: []string{key}, Err: unknownKeyError(key, keys, suggest)})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func unknownKeys(value map[string]any, keys []string) []string {
+	known := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		known[key] = struct{}{}
+	}
+
+	var unknown []string
+	for key := range value {
+		if _, ok := known[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+func unknownKeyError(key string, keys []string, suggest bool) error {
+	if suggest {
+		if closest, ok := closestKey(key, keys); ok {
+			return &CodeError{Code: CodeUnknownKey, Err: fmt.Errorf("unknown key, did you mean \"%v\"?", closest)}
+		}
+	}
+	return &CodeError{Code: CodeUnknownKey, Err: fmt.Errorf("unknown key")}
+}
+
+// closestKey returns the key nearest to key by edit distance, as long as less
+// than half of key has to change.
+func closestKey(key string, keys []string) (string, bool) {
+	closest := ""
+	best := -1
+	for _, candidate := range keys {
+		distance := levenshtein(key, candidate)
+		if best == -1 || distance < best {
+			closest = candidate
+			best = distance
+		}
+	}
+	if best == -1 || best > len([]rune(key))/2 {
+		return "", false
+	}
+	return closest, true
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j] + 1
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+			if previous[j-1]+cost < current[j] {
+				current[j] = previous[j-1] + cost
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
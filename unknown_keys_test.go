@@ -0,0 +1,90 @@
+package validator_test
+
+import (
+	"github.com/gungun974/validator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func unknownKeysTests() {
+	fields := []validator.Field{
+		validator.StringField("email", validator.StringValidators{}),
+		validator.StringField("name", validator.StringValidators{}).Optional(),
+	}
+
+	Describe("Schema", func() {
+		It("should strip unknown keys by default", func() {
+			// arrange
+			schema := validator.Schema{Fields: fields}
+
+			// act
+			result, err := schema.Validate(map[string]any{"email": "john@doe.com", "admin": true})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(map[string]any{"email": "john@doe.com"}))
+		})
+
+		It("should report every unknown key in strict mode", func() {
+			// arrange
+			schema := validator.Schema{Fields: fields, UnknownKeys: validator.UnknownKeysStrict}
+
+			// act
+			_, err := schema.Validate(map[string]any{"email": "john@doe.com", "emial": "x", "admin": true})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("admin: unknown key, emial: unknown key"))
+		})
+
+		It("should suggest the closest declared key", func() {
+			// arrange
+			schema := validator.Schema{
+				Fields:      fields,
+				UnknownKeys: validator.UnknownKeysStrict,
+				SuggestKeys: true,
+			}
+
+			// act
+			_, err := schema.Validate(map[string]any{"emial": "john@doe.com", "admin": true})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal(
+				"email: missing key \"email\", admin: unknown key, emial: unknown key, did you mean \"email\"?",
+			))
+		})
+
+		It("should copy unknown keys in passthrough mode", func() {
+			// arrange
+			schema := validator.Schema{Fields: fields, UnknownKeys: validator.UnknownKeysPassthrough}
+
+			// act
+			result, err := schema.Validate(map[string]any{"email": "john@doe.com", "admin": true})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(map[string]any{"email": "john@doe.com", "admin": true}))
+		})
+	})
+
+	Describe("ValidateKnownKeys", func() {
+		It("should accept a map of known keys", func() {
+			// act
+			err := validator.ValidateKnownKeys(map[string]any{"name": "John"}, []string{"email", "name"}, true)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should report unknown keys with their suggestion", func() {
+			// act
+			err := validator.ValidateKnownKeys(map[string]any{"nmae": "John"}, []string{"email", "name"}, true)
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("nmae: unknown key, did you mean \"name\"?"))
+		})
+	})
+}
@@ -1,37 +1,283 @@
 package validator
 
 import (
-	"errors"
+	"encoding/binary"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-func ValidateMapUUID(name string, value map[string]any) (uuid.UUID, error) {
-	rawValue, ok := value[name]
+// WithCanonicalUUID only accepts UUID strings in the hyphenated 36 characters
+// form, refusing braces, "urn:uuid:" prefixes and unhyphenated UUIDs.
+func WithCanonicalUUID() Option {
+	return func(o *options) {
+		o.canonicalUUID = true
+	}
+}
+
+type UUIDValidators []uuidValidator
+
+type uuidValidator interface {
+	Validate(value uuid.UUID) error
+}
+
+type UUIDVersionValidator struct {
+	Versions []uuid.Version
+}
+
+func (v UUIDVersionValidator) Validate(value uuid.UUID) error {
+	versions := make([]string, 0, len(v.Versions))
+	for _, version := range v.Versions {
+		if value.Version() == version {
+			return nil
+		}
+		versions = append(versions, fmt.Sprint(int(version)))
+	}
+	return fmt.Errorf("value must be a version %v UUID", strings.Join(versions, ", "))
+}
+
+func (v UUIDVersionValidator) Describe() Description {
+	versions := make([]int, 0, len(v.Versions))
+	for _, version := range v.Versions {
+		versions = append(versions, int(version))
+	}
+	summary := fmt.Sprintf("a version %v UUID", joinValues(versions))
+	return Description{
+		Kind:    "uuid.version",
+		Params:  map[string]any{"versions": v.Versions},
+		Summary: summary,
+	}
+}
+
+type UUIDVariantValidator struct {
+	Variant uuid.Variant
+}
+
+func (v UUIDVariantValidator) Validate(value uuid.UUID) error {
+	if value.Variant() != v.Variant {
+		return fmt.Errorf("value must be a %v variant UUID", v.Variant)
+	}
+	return nil
+}
+
+func (v UUIDVariantValidator) Describe() Description {
+	return Description{
+		Kind:    "uuid.variant",
+		Params:  map[string]any{"variant": v.Variant},
+		Summary: fmt.Sprintf("a %v variant UUID", v.Variant),
+	}
+}
+
+type UUIDNotNilValidator struct{}
+
+func (v UUIDNotNilValidator) Validate(value uuid.UUID) error {
+	if value == uuid.Nil {
+		return fmt.Errorf("value must not be the nil UUID")
+	}
+	return nil
+}
+
+func (v UUIDNotNilValidator) Describe() Description {
+	return Description{Kind: "uuid.not_nil", Summary: "must not be the nil UUID"}
+}
+
+// UUIDTimeMaxValidator checks the timestamp embedded in version 1, 6 and 7 UUIDs.
+type UUIDTimeMaxValidator struct {
+	Max time.Time
+}
+
+func (v UUIDTimeMaxValidator) Validate(value uuid.UUID) error {
+	timestamp, ok := uuidTime(value)
 	if !ok {
-		return uuid.UUID{}, fmt.Errorf("missing key \"%v\"", name)
+		return fmt.Errorf("value is not a time-based UUID")
+	}
+	if timestamp.After(v.Max) {
+		return fmt.Errorf("value must not be created after %v", v.Max)
 	}
-	return ValidateUUID(rawValue)
+	return nil
 }
 
-func ValidateUUID(value any) (uuid.UUID, error) {
-	uuidValue, uuidOk := value.(uuid.UUID)
+func (v UUIDTimeMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "uuid.time_max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("created at or before %v", v.Max.Format(time.RFC3339)),
+	}
+}
+
+// UUIDTimeMinValidator checks the timestamp embedded in version 1, 6 and 7 UUIDs.
+type UUIDTimeMinValidator struct {
+	Min time.Time
+}
+
+func (v UUIDTimeMinValidator) Validate(value uuid.UUID) error {
+	timestamp, ok := uuidTime(value)
+	if !ok {
+		return fmt.Errorf("value is not a time-based UUID")
+	}
+	if timestamp.Before(v.Min) {
+		return fmt.Errorf("value must not be created before %v", v.Min)
+	}
+	return nil
+}
 
-	if uuidOk {
-		return uuidValue, nil
+func (v UUIDTimeMinValidator) Describe() Description {
+	return Description{
+		Kind:    "uuid.time_min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("created at or after %v", v.Min.Format(time.RFC3339)),
 	}
+}
 
-	stringValue, stringOk := value.(string)
+func uuidTime(value uuid.UUID) (time.Time, bool) {
+	var timestamp uuid.Time
 
-	if !stringOk {
-		return uuid.UUID{}, errors.New("value is not a string or UUID")
+	switch value.Version() {
+	case 1, 7:
+		timestamp = value.Time()
+	case 6:
+		timestamp = uuid.Time(uint64(binary.BigEndian.Uint32(value[0:4]))<<28 |
+			uint64(binary.BigEndian.Uint16(value[4:6]))<<12 |
+			uint64(binary.BigEndian.Uint16(value[6:8])&0xfff))
+	default:
+		return time.Time{}, false
 	}
 
-	uuidValue, err := uuid.Parse(stringValue)
+	sec, nsec := timestamp.UnixTime()
+	return time.Unix(sec, nsec).UTC(), true
+}
+
+func ValidateMapUUID(name string, value map[string]any, opts ...Option) (uuid.UUID, error) {
+	return ValidateMapUUIDWith(name, value, nil, opts...)
+}
+
+// ValidateMapUUIDWith is ValidateMapUUID checking rules on the UUID.
+func ValidateMapUUIDWith(
+	name string,
+	value map[string]any,
+	rules UUIDValidators,
+	opts ...Option,
+) (uuid.UUID, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
 	if err != nil {
-		return uuid.UUID{}, errors.New("value is an invalid UUID")
+		return uuid.UUID{}, err
+	}
+	if !ok {
+		return uuid.UUID{}, missingKeyError(name)
+	}
+	return ValidateUUIDWith(rawValue, rules, opts...)
+}
+
+func ValidateMapUUIDOrNil(name string, value map[string]any, opts ...Option) (*uuid.UUID, error) {
+	return ValidateMapUUIDWithOrNil(name, value, nil, opts...)
+}
+
+func ValidateMapUUIDOrDefault(
+	name string,
+	value map[string]any,
+	defaultValue uuid.UUID,
+	opts ...Option,
+) (uuid.UUID, error) {
+	return ValidateMapUUIDWithOrDefault(name, value, nil, defaultValue, opts...)
+}
+
+func ValidateMapUUIDOrDefaultFunc(
+	name string,
+	value map[string]any,
+	defaultValue func() uuid.UUID,
+	opts ...Option,
+) (uuid.UUID, error) {
+	return ValidateMapUUIDWithOrDefaultFunc(name, value, nil, defaultValue, opts...)
+}
+
+// ValidateMapUUIDWithOrNil is ValidateMapUUIDOrNil checking rules on the UUID.
+func ValidateMapUUIDWithOrNil(
+	name string,
+	value map[string]any,
+	rules UUIDValidators,
+	opts ...Option,
+) (*uuid.UUID, error) {
+	return validateMapOrNil(name, value, ValidateUUIDWith, rules, opts)
+}
+
+// ValidateMapUUIDWithOrDefault is ValidateMapUUIDOrDefault checking rules on
+// the UUID.
+func ValidateMapUUIDWithOrDefault(
+	name string,
+	value map[string]any,
+	rules UUIDValidators,
+	defaultValue uuid.UUID,
+	opts ...Option,
+) (uuid.UUID, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateUUIDWith, rules, func() uuid.UUID {
+		return defaultValue
+	}, opts)
+}
+
+// ValidateMapUUIDWithOrDefaultFunc is ValidateMapUUIDOrDefaultFunc checking
+// rules on the UUID.
+func ValidateMapUUIDWithOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules UUIDValidators,
+	defaultValue func() uuid.UUID,
+	opts ...Option,
+) (uuid.UUID, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateUUIDWith, rules, defaultValue, opts)
+}
+
+func ValidateUUID(value any, opts ...Option) (uuid.UUID, error) {
+	return ValidateUUIDWith(value, nil, opts...)
+}
+
+// ValidateUUIDWith is ValidateUUID checking rules on the UUID.
+func ValidateUUIDWith(value any, rules UUIDValidators, opts ...Option) (uuid.UUID, error) {
+	o := newOptions(opts)
+
+	uuidValue, uuidOk := value.(uuid.UUID)
+
+	if !uuidOk {
+		stringValue, stringOk := value.(string)
+
+		if !stringOk {
+			return uuid.UUID{}, typeError("value is not a string or UUID")
+		}
+
+		if o.canonicalUUID && !isCanonicalUUID(stringValue) {
+			return uuid.UUID{}, typeError("value is not a canonical UUID")
+		}
+
+		var err error
+		uuidValue, err = uuid.Parse(stringValue)
+		if err != nil {
+			return uuid.UUID{}, typeError("value is an invalid UUID")
+		}
+	}
+
+	if err := applyRules(o.context(), rules, uuidValue); err != nil {
+		return uuid.UUID{}, err
 	}
 
 	return uuidValue, nil
 }
+
+func isCanonicalUUID(value string) bool {
+	if len(value) != 36 {
+		return false
+	}
+	for i, c := range value {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+				return false
+			}
+		}
+	}
+	return true
+}
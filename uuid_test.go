@@ -1,6 +1,8 @@
 package validator_test
 
 import (
+	"time"
+
 	"github.com/gungun974/validator"
 
 	"github.com/google/uuid"
@@ -59,5 +61,186 @@ func uuidValidatorTests() {
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).To(Equal("value is not a string or UUID"))
 		})
+
+		It("should return an UUID when all rules are satisfied", func() {
+			// arrange
+			value := uuid.New()
+
+			// act
+			result, err := validator.ValidateUUIDWith(value, validator.UUIDValidators{
+				validator.UUIDNotNilValidator{},
+				validator.UUIDVersionValidator{Versions: []uuid.Version{4, 7}},
+				validator.UUIDVariantValidator{Variant: uuid.RFC4122},
+			})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(value))
+		})
+
+		It("should not return an UUID when input is the nil UUID", func() {
+			// arrange
+			value := uuid.Nil.String()
+
+			// act
+			_, err := validator.ValidateUUIDWith(value, validator.UUIDValidators{
+				validator.UUIDNotNilValidator{},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be the nil UUID"))
+		})
+
+		It("should not return an UUID when its version is not allowed", func() {
+			// arrange
+			value := uuid.NewMD5(uuid.NameSpaceURL, []byte("https://example.com"))
+
+			// act
+			_, err := validator.ValidateUUIDWith(value, validator.UUIDValidators{
+				validator.UUIDVersionValidator{Versions: []uuid.Version{4, 7}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must be a version 4, 7 UUID"))
+		})
+
+		It("should not return an UUID when its variant is not allowed", func() {
+			// arrange
+			value := uuid.MustParse("6ba7b810-9dad-11d1-c0b4-00c04fd430c8")
+
+			// act
+			_, err := validator.ValidateUUIDWith(value, validator.UUIDValidators{
+				validator.UUIDVariantValidator{Variant: uuid.RFC4122},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must be a RFC4122 variant UUID"))
+		})
+
+		DescribeTable("should only accept canonical UUID strings in canonical mode",
+			func(value string, valid bool) {
+				// act
+				_, err := validator.ValidateUUID(value, validator.WithCanonicalUUID())
+
+				// assert
+				if valid {
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(Equal("value is not a canonical UUID"))
+				}
+			},
+			Entry("hyphenated", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", true),
+			Entry("braces", "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", false),
+			Entry("urn prefix", "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", false),
+			Entry("unhyphenated", "6ba7b8109dad11d180b400c04fd430c8", false),
+		)
+
+		It("should accept braces without canonical mode", func() {
+			// arrange
+			value := "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"
+
+			// act
+			result, err := validator.ValidateUUID(value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.String()).To(Equal("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+		})
+
+		Describe("UUID time rules", func() {
+			// 017f22e2-79b0-7cc3-98c4-dc0c0c07398f was created on 2022-02-22T19:22:22Z
+			value := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+			created := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+
+			It("should return an UUID created between min and max", func() {
+				// act
+				_, err := validator.ValidateUUIDWith(value, validator.UUIDValidators{
+					validator.UUIDTimeMinValidator{Min: created.Add(-time.Second)},
+					validator.UUIDTimeMaxValidator{Max: created.Add(time.Second)},
+				})
+
+				// assert
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should not return an UUID created before min", func() {
+				// act
+				_, err := validator.ValidateUUIDWith(value, validator.UUIDValidators{
+					validator.UUIDTimeMinValidator{Min: created.Add(time.Second)},
+				})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(HavePrefix("value must not be created before"))
+			})
+
+			It("should not return an UUID created after max", func() {
+				// act
+				_, err := validator.ValidateUUIDWith(value, validator.UUIDValidators{
+					validator.UUIDTimeMaxValidator{Max: created.Add(-time.Second)},
+				})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(HavePrefix("value must not be created after"))
+			})
+
+			It("should not return an UUID without timestamp", func() {
+				// act
+				_, err := validator.ValidateUUIDWith(uuid.New(), validator.UUIDValidators{
+					validator.UUIDTimeMinValidator{Min: created},
+				})
+
+				// assert
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(Equal("value is not a time-based UUID"))
+			})
+		})
+	})
+
+	Describe("ValidateMapUUIDOrNil", func() {
+		It("should return nil when key is missing", func() {
+			// arrange
+			value := map[string]any{}
+
+			// act
+			result, err := validator.ValidateMapUUIDOrNil("id", value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeNil())
+		})
+
+		It("should return an UUID when key is present", func() {
+			// arrange
+			target := uuid.New()
+			value := map[string]any{"id": target.String()}
+
+			// act
+			result, err := validator.ValidateMapUUIDOrNil("id", value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(*result).To(Equal(target))
+		})
+	})
+
+	Describe("ValidateMapUUIDWithOrNil", func() {
+		It("should check the rules when key is present", func() {
+			// arrange
+			value := map[string]any{"id": uuid.Nil.String()}
+
+			// act
+			_, err := validator.ValidateMapUUIDWithOrNil("id", value, validator.UUIDValidators{
+				validator.UUIDNotNilValidator{},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+		})
 	})
 }
@@ -18,5 +18,27 @@ var _ = Describe("Validator", func() {
 	Describe("StringValidator", stringValidatorTests)
 	Describe("BoolValidator", boolValidatorTests)
 	Describe("UUIDValidator", uuidValidatorTests)
+	Describe("ULIDValidator", ulidValidatorTests)
+	Describe("KSUIDValidator", ksuidValidatorTests)
+	Describe("SnowflakeValidator", snowflakeValidatorTests)
+	Describe("NanoIDValidator", nanoIDValidatorTests)
+	Describe("Coercion", coercionTests)
+	Describe("Schema", schemaTests)
+	Describe("ObjectValidator", objectValidatorTests)
+	Describe("Presence", presenceTests)
+	Describe("Lookup", lookupValidatorTests)
+	Describe("Optional", optionalTests)
+	Describe("Null", nullTests)
+	Describe("UnknownKeys", unknownKeysTests)
+	Describe("Rule", ruleTests)
+	Describe("Describe", describeTests)
+	Describe("DSL", dslTests)
+	Describe("ErrorCode", errorCodeTests)
 	Describe("TimeValidator", timeValidatorTests)
+	Describe("Clock", clockTests)
+	Describe("DurationValidator", durationValidatorTests)
+	Describe("DateValidator", dateValidatorTests)
+	Describe("TimeOfDayValidator", timeOfDayValidatorTests)
+	Describe("YearMonthValidator", yearMonthValidatorTests)
+	Describe("IntervalValidator", intervalValidatorTests)
 })
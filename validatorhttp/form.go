@@ -0,0 +1,150 @@
+package validatorhttp
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"net/url"
+	"strings"
+
+	"github.com/gungun974/validator"
+)
+
+// Form is the state of a submitted HTML form, to render it again with the
+// values the user typed and the error of each field. A nil *Form is an empty
+// form, as rendered before the first submission.
+type Form struct {
+	input  map[string]any
+	values map[string]any
+	errors map[string][]string
+}
+
+// NewForm returns the state of input, the submitted values, after a
+// validation returned values and err. Field errors are keyed by their dotted
+// path, which for the RequestError of the middleware leaves out the part of
+// the request, as input holds a single part such as r.PostForm. Any other
+// error, such as the error of a single ValidateMap call, is an error of the
+// whole form, read with the empty field name.
+func NewForm(input map[string]any, values map[string]any, err error) *Form {
+	form := &Form{input: input, values: values, errors: map[string][]string{}}
+	if err == nil {
+		return form
+	}
+
+	var reqErr *RequestError
+	var errs validator.Errors
+	switch {
+	case errors.As(err, &reqErr):
+		errs = append(append(append(errs, reqErr.Path...), reqErr.Query...), reqErr.Body...)
+	case !errors.As(err, &errs):
+		errs = validator.Errors{{Err: err}}
+	}
+	for _, fieldErr := range errs {
+		field := fieldErr.Field()
+		form.errors[field] = append(form.errors[field], fieldErr.Err.Error())
+	}
+	return form
+}
+
+// NewFormValues is NewForm for url.Values, such as the PostForm of a request.
+func NewFormValues(input url.Values, values map[string]any, err error) *Form {
+	return NewForm(formValues(input), values, err)
+}
+
+// Value returns the raw submitted value of field, even when it failed to
+// validate, falling back to its validated value such as a default.
+func (f *Form) Value(field string) string {
+	values := f.Values(field)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Values returns every submitted value of a repeated field, such as a
+// multiple select.
+func (f *Form) Values(field string) []string {
+	if f == nil {
+		return nil
+	}
+	value, ok := lookupPath(f.input, field)
+	if !ok {
+		value, ok = lookupPath(f.values, field)
+	}
+	if !ok || value == nil {
+		return nil
+	}
+
+	if list, ok := value.([]any); ok {
+		values := make([]string, 0, len(list))
+		for _, item := range list {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	}
+	if list, ok := value.([]string); ok {
+		return list
+	}
+	return []string{fmt.Sprint(value)}
+}
+
+// Errors returns the error messages of field.
+func (f *Form) Errors(field string) []string {
+	if f == nil {
+		return nil
+	}
+	return f.errors[field]
+}
+
+// Error returns the first error message of field.
+func (f *Form) Error(field string) string {
+	errs := f.Errors(field)
+	if len(errs) == 0 {
+		return ""
+	}
+	return errs[0]
+}
+
+func (f *Form) HasError(field string) bool {
+	return len(f.Errors(field)) > 0
+}
+
+// Valid reports whether the form has no error at all.
+func (f *Form) Valid() bool {
+	return f == nil || len(f.errors) == 0
+}
+
+// FormFuncMap returns the template functions reading a *Form:
+//
+//	<input name="email" value="{{fieldValue .Form "email"}}"
+//		{{if hasError .Form "email"}}aria-invalid="true"{{end}}>
+//	{{range fieldErrors .Form "email"}}<p class="error">{{.}}</p>{{end}}
+func FormFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"fieldValue":  (*Form).Value,
+		"fieldValues": (*Form).Values,
+		"fieldError":  (*Form).Error,
+		"fieldErrors": (*Form).Errors,
+		"hasError":    (*Form).HasError,
+	}
+}
+
+// lookupPath reads a dotted path through nested maps.
+func lookupPath(value map[string]any, path string) (any, bool) {
+	if value == nil {
+		return nil, false
+	}
+	if item, ok := value[path]; ok {
+		return item, true
+	}
+
+	key, rest, found := strings.Cut(path, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := value[key].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return lookupPath(nested, rest)
+}
@@ -0,0 +1,140 @@
+package validatorhttp_test
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/gungun974/validator"
+	"github.com/gungun974/validator/validatorhttp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Form", func() {
+	schema := validator.Schema{
+		Fields: []validator.Field{
+			validator.StringField("email", validator.StringValidators{validator.StringEmailValidator{}}),
+			{
+				Name:     "age",
+				Required: true,
+				Validate: func(value any, opts ...validator.Option) (any, error) {
+					return validator.CoerceAndValidateInt(value, validator.IntValidators{}, opts...)
+				},
+			},
+			validator.TimeField("start", validator.TimeValidators{}),
+		},
+		Options: []validator.Option{validator.WithCoercion(validator.CoercionForm)},
+	}
+
+	It("should keep the raw values that failed to coerce", func() {
+		// arrange
+		input := url.Values{"email": {"john@example.com"}, "age": {"forty"}, "start": {"tomorrow"}}
+		values, err := schema.Validate(map[string]any{"email": "john@example.com", "age": "forty", "start": "tomorrow"})
+
+		// act
+		form := validatorhttp.NewFormValues(input, values, err)
+
+		// assert
+		Expect(form.Valid()).To(BeFalse())
+		Expect(form.Value("age")).To(Equal("forty"))
+		Expect(form.Value("start")).To(Equal("tomorrow"))
+		Expect(form.Value("email")).To(Equal("john@example.com"))
+		Expect(form.HasError("email")).To(BeFalse())
+		Expect(form.HasError("age")).To(BeTrue())
+		Expect(form.Errors("start")).To(Equal([]string{"value is not a time"}))
+	})
+
+	It("should read repeated and nested values", func() {
+		// arrange
+		input := map[string]any{
+			"tags":    []any{"a", "b"},
+			"address": map[string]any{"city": "Paris"},
+		}
+		err := validator.Errors{{Path: []string{"address", "city"}, Err: errors.New("value is not a city")}}
+
+		// act
+		form := validatorhttp.NewForm(input, nil, err)
+
+		// assert
+		Expect(form.Values("tags")).To(Equal([]string{"a", "b"}))
+		Expect(form.Value("address.city")).To(Equal("Paris"))
+		Expect(form.Error("address.city")).To(Equal("value is not a city"))
+	})
+
+	It("should fall back to the validated value", func() {
+		// act
+		form := validatorhttp.NewForm(map[string]any{}, map[string]any{"page": 1}, nil)
+
+		// assert
+		Expect(form.Valid()).To(BeTrue())
+		Expect(form.Value("page")).To(Equal("1"))
+	})
+
+	It("should keep an error without a field on the whole form", func() {
+		// act
+		form := validatorhttp.NewForm(nil, nil, errors.New("value must be true"))
+
+		// assert
+		Expect(form.Errors("")).To(Equal([]string{"value must be true"}))
+	})
+
+	It("should render a nil form as empty", func() {
+		// arrange
+		var form *validatorhttp.Form
+
+		// act & assert
+		Expect(form.Valid()).To(BeTrue())
+		Expect(form.Value("email")).To(BeEmpty())
+		Expect(form.HasError("email")).To(BeFalse())
+	})
+
+	It("should key the errors of the middleware like the submitted form", func() {
+		// arrange
+		var form *validatorhttp.Form
+		mw := validatorhttp.Middleware(validatorhttp.Request[validatorhttp.Values]{
+			Body: validator.Schema{Fields: []validator.Field{
+				validator.StringField("email", validator.StringValidators{validator.StringEmailValidator{}}),
+				validator.StringField("name", validator.StringValidators{}),
+			}},
+			ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+				form = validatorhttp.NewFormValues(r.PostForm, nil, err)
+			},
+		})
+		r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader("email=john&name=John"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		// act
+		mw(http.NotFoundHandler()).ServeHTTP(httptest.NewRecorder(), r)
+
+		// assert
+		Expect(form.Value("email")).To(Equal("john"))
+		Expect(form.Errors("email")).To(Equal([]string{"value is not an email"}))
+		Expect(form.Value("name")).To(Equal("John"))
+		Expect(form.HasError("name")).To(BeFalse())
+	})
+
+	It("should expose the form to templates", func() {
+		// arrange
+		tmpl := template.Must(template.New("form").Funcs(validatorhttp.FormFuncMap()).Parse(
+			`<input name="age" value="{{fieldValue . "age"}}"{{if hasError . "age"}} aria-invalid="true"{{end}}>` +
+				`{{range fieldErrors . "age"}}<p>{{.}}</p>{{end}}`,
+		))
+		_, err := schema.Validate(map[string]any{"age": `"><script>`})
+		form := validatorhttp.NewForm(map[string]any{"age": `"><script>`}, nil, err)
+		var b strings.Builder
+
+		// act
+		renderErr := tmpl.Execute(&b, form)
+
+		// assert
+		Expect(renderErr).NotTo(HaveOccurred())
+		Expect(b.String()).To(Equal(
+			`<input name="age" value="&#34;&gt;&lt;script&gt;" aria-invalid="true"><p>value is not a number</p>`,
+		))
+	})
+})
@@ -0,0 +1,324 @@
+// Package validatorhttp validates HTTP requests with validator schemas before
+// they reach their handler, which reads the validated values from the request
+// context. It also renders validation errors as problem documents and as the
+// state of an HTML form.
+//
+//	type listParams struct {
+//		Page int
+//	}
+//
+//	mw := validatorhttp.Middleware(validatorhttp.Request[listParams]{
+//		Query: validator.Schema{Fields: []validator.Field{
+//			validator.IntField("page", validator.IntValidators{validator.IntMinValidator{Min: 1}}),
+//		}},
+//		Bind: func(v validatorhttp.Values) (listParams, error) {
+//			return listParams{Page: v.Query["page"].(int)}, nil
+//		},
+//	})
+//
+//	http.Handle("/items", mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//		params := validatorhttp.MustFromContext[listParams](r.Context())
+//		...
+//	})))
+package validatorhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/gungun974/validator"
+)
+
+// DefaultMaxBodyBytes is the size limit of a request body when
+// Request.MaxBodyBytes is zero.
+const DefaultMaxBodyBytes = 1 << 20
+
+// Values holds the validated values of each part of a request.
+type Values struct {
+	Path  map[string]any
+	Query map[string]any
+	Body  map[string]any
+}
+
+// Request describes how to validate a request and bind its values to T.
+type Request[T any] struct {
+	Path  validator.Schema
+	Query validator.Schema
+	// Body reads a JSON body, or a form body when the request is a form.
+	Body validator.Schema
+
+	// Bind turns the validated values into T. It may be nil when T is Values.
+	Bind func(values Values) (T, error)
+
+	// PathValue reads a path parameter, defaulting to the PathValue method of
+	// the request when it has one.
+	PathValue func(r *http.Request, name string) string
+
+	// ErrorHandler writes the response of an invalid request, defaulting to
+	// WriteError.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+
+	MaxBodyBytes int64
+
+	// Options apply to every part, after the coercion of the part: CoercionForm
+	// for the path, the query and form bodies, CoercionJSON for JSON bodies.
+	Options []validator.Option
+}
+
+// BodyError reports a body that could not be read or decoded.
+type BodyError struct {
+	Status int
+	Err    error
+}
+
+func (e *BodyError) Error() string {
+	return fmt.Sprintf("invalid body: %v", e.Err)
+}
+
+func (e *BodyError) Unwrap() error {
+	return e.Err
+}
+
+// RequestError is the error of an invalid request, with the field errors of
+// each part. It unwraps to a validator.Errors whose paths start with "path",
+// "query" or "body".
+type RequestError struct {
+	Path  validator.Errors
+	Query validator.Errors
+	Body  validator.Errors
+}
+
+func (e *RequestError) Error() string {
+	return e.errors().Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.errors()
+}
+
+func (e *RequestError) errors() validator.Errors {
+	var errs validator.Errors
+	for _, part := range []struct {
+		name string
+		errs validator.Errors
+	}{{"path", e.Path}, {"query", e.Query}, {"body", e.Body}} {
+		if len(part.errs) > 0 {
+			errs = append(errs, validator.PrefixErrors(part.name, part.errs)...)
+		}
+	}
+	return errs
+}
+
+type contextKey[T any] struct{}
+
+// NewContext returns a copy of ctx holding value, as read by FromContext.
+func NewContext[T any](ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, contextKey[T]{}, value)
+}
+
+// FromContext returns the value stored by the middleware of a Request[T].
+func FromContext[T any](ctx context.Context) (T, bool) {
+	value, ok := ctx.Value(contextKey[T]{}).(T)
+	return value, ok
+}
+
+// MustFromContext is FromContext panicking when the middleware did not run.
+func MustFromContext[T any](ctx context.Context) T {
+	value, ok := FromContext[T](ctx)
+	if !ok {
+		var zero T
+		panic(fmt.Sprintf("validatorhttp: no %T in context", zero))
+	}
+	return value
+}
+
+// Middleware validates every request with req, answering with its
+// ErrorHandler when the request is invalid and otherwise calling the next
+// handler with the bound value in the request context.
+func Middleware[T any](req Request[T]) func(http.Handler) http.Handler {
+	if req.Bind == nil {
+		if _, ok := any(Values{}).(T); !ok {
+			panic("validatorhttp: Request.Bind is required unless T is Values")
+		}
+		req.Bind = func(values Values) (T, error) {
+			return any(values).(T), nil
+		}
+	}
+	if req.PathValue == nil {
+		req.PathValue = requestPathValue
+	}
+	if req.ErrorHandler == nil {
+		req.ErrorHandler = WriteError
+	}
+	if req.MaxBodyBytes == 0 {
+		req.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value, err := req.validate(w, r)
+			if err != nil {
+				req.ErrorHandler(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), value)))
+		})
+	}
+}
+
+func (req Request[T]) validate(w http.ResponseWriter, r *http.Request) (T, error) {
+	var zero T
+	var values Values
+	var reqErr RequestError
+
+	formOpts := append([]validator.Option{validator.WithCoercion(validator.CoercionForm)}, req.Options...)
+
+	pathInput := map[string]any{}
+	for _, field := range req.Path.Fields {
+		if value := req.PathValue(r, field.Name); value != "" {
+			pathInput[field.Name] = value
+		}
+	}
+
+	parts := []struct {
+		name   string
+		schema validator.Schema
+		input  func() (map[string]any, []validator.Option, error)
+		result *map[string]any
+		errs   *validator.Errors
+	}{
+		{"path", req.Path, func() (map[string]any, []validator.Option, error) {
+			return pathInput, formOpts, nil
+		}, &values.Path, &reqErr.Path},
+		{"query", req.Query, func() (map[string]any, []validator.Option, error) {
+			return formValues(r.URL.Query()), formOpts, nil
+		}, &values.Query, &reqErr.Query},
+		{"body", req.Body, func() (map[string]any, []validator.Option, error) {
+			return req.readBody(w, r, formOpts)
+		}, &values.Body, &reqErr.Body},
+	}
+
+	for _, part := range parts {
+		if len(part.schema.Fields) == 0 {
+			continue
+		}
+		input, opts, err := part.input()
+		if err != nil {
+			return zero, err
+		}
+		result, err := part.schema.ValidateContext(r.Context(), input, opts...)
+		if validator.IsLookupError(err) {
+			return zero, err
+		}
+		if err != nil {
+			if !errors.As(err, part.errs) {
+				return zero, err
+			}
+			continue
+		}
+		*part.result = result
+	}
+
+	if len(reqErr.Path) > 0 || len(reqErr.Query) > 0 || len(reqErr.Body) > 0 {
+		return zero, &reqErr
+	}
+	return req.Bind(values)
+}
+
+func (req Request[T]) readBody(
+	w http.ResponseWriter,
+	r *http.Request,
+	formOpts []validator.Option,
+) (map[string]any, []validator.Option, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, req.MaxBodyBytes)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded", "multipart/form-data":
+		if err := r.ParseMultipartForm(req.MaxBodyBytes); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			return nil, nil, bodyError(err)
+		}
+		return formValues(r.PostForm), formOpts, nil
+	}
+
+	body := map[string]any{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, bodyError(err)
+	}
+	jsonOpts := append([]validator.Option{validator.WithCoercion(validator.CoercionJSON)}, req.Options...)
+	return body, jsonOpts, nil
+}
+
+func bodyError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &BodyError{Status: http.StatusRequestEntityTooLarge, Err: err}
+	}
+	return &BodyError{Status: http.StatusBadRequest, Err: err}
+}
+
+// formValues reads a key given once as a string and a repeated key as a list.
+func formValues(form url.Values) map[string]any {
+	values := make(map[string]any, len(form))
+	for key, items := range form {
+		if len(items) == 1 {
+			values[key] = items[0]
+			continue
+		}
+		list := make([]any, 0, len(items))
+		for _, item := range items {
+			list = append(list, item)
+		}
+		values[key] = list
+	}
+	return values
+}
+
+// requestPathValue calls the PathValue method requests have since Go 1.22.
+func requestPathValue(r *http.Request, name string) string {
+	if pathValuer, ok := any(r).(interface{ PathValue(name string) string }); ok {
+		return pathValuer.PathValue(name)
+	}
+	return ""
+}
+
+// WriteError answers 400 with the field errors of an invalid request as
+// JSON, the status of a BodyError, or 500 for any other error such as a
+// validator.LookupError.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	type fieldError struct {
+		Path    []string `json:"path"`
+		Message string   `json:"message"`
+	}
+
+	var errs validator.Errors
+	var bodyErr *BodyError
+	status := http.StatusInternalServerError
+	response := struct {
+		Errors []fieldError `json:"errors"`
+	}{}
+
+	switch {
+	case errors.As(err, &errs):
+		status = http.StatusBadRequest
+		for _, fieldErr := range errs {
+			response.Errors = append(response.Errors, fieldError{Path: fieldErr.Path, Message: fieldErr.Err.Error()})
+		}
+	case errors.As(err, &bodyErr):
+		status = bodyErr.Status
+		response.Errors = []fieldError{{Path: []string{"body"}, Message: bodyErr.Error()}}
+	default:
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
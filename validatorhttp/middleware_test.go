@@ -0,0 +1,210 @@
+package validatorhttp_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gungun974/validator"
+	"github.com/gungun974/validator/validatorhttp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidatorhttp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validatorhttp")
+}
+
+type listParams struct {
+	ID   int
+	Page int
+	Name string
+}
+
+func serve(mw func(http.Handler) http.Handler, r *http.Request) (*httptest.ResponseRecorder, *listParams) {
+	var got *listParams
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := validatorhttp.MustFromContext[listParams](r.Context())
+		got = &params
+		w.WriteHeader(http.StatusNoContent)
+	})
+	rec := httptest.NewRecorder()
+	mw(next).ServeHTTP(rec, r)
+	return rec, got
+}
+
+var _ = Describe("Middleware", func() {
+	request := validatorhttp.Request[listParams]{
+		Path: validator.Schema{Fields: []validator.Field{
+			validator.IntField("id", validator.IntValidators{}),
+		}},
+		Query: validator.Schema{Fields: []validator.Field{
+			validator.IntField("page", validator.IntValidators{validator.IntMinValidator{Min: 1}}),
+		}},
+		Body: validator.Schema{Fields: []validator.Field{
+			validator.StringField("name", validator.StringValidators{validator.StringMinValidator{Min: 2}}),
+		}},
+		PathValue: func(r *http.Request, name string) string {
+			return strings.TrimPrefix(r.URL.Path, "/items/")
+		},
+		Bind: func(v validatorhttp.Values) (listParams, error) {
+			return listParams{
+				ID:   v.Path["id"].(int),
+				Page: v.Query["page"].(int),
+				Name: v.Body["name"].(string),
+			}, nil
+		},
+	}
+
+	It("should store the bound values of a valid JSON request", func() {
+		// arrange
+		r := httptest.NewRequest(http.MethodPost, "/items/7?page=2", strings.NewReader(`{"name":"John"}`))
+		r.Header.Set("Content-Type", "application/json")
+
+		// act
+		rec, got := serve(validatorhttp.Middleware(request), r)
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+		Expect(got).To(Equal(&listParams{ID: 7, Page: 2, Name: "John"}))
+	})
+
+	It("should read a form body", func() {
+		// arrange
+		r := httptest.NewRequest(http.MethodPost, "/items/7?page=2", strings.NewReader("name=John"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		// act
+		rec, got := serve(validatorhttp.Middleware(request), r)
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+		Expect(got).To(Equal(&listParams{ID: 7, Page: 2, Name: "John"}))
+	})
+
+	It("should answer 400 with the errors of every part", func() {
+		// arrange
+		r := httptest.NewRequest(http.MethodPost, "/items/x?page=0", strings.NewReader(`{"name":"J"}`))
+		r.Header.Set("Content-Type", "application/json")
+
+		// act
+		rec, got := serve(validatorhttp.Middleware(request), r)
+
+		// assert
+		Expect(got).To(BeNil())
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+		Expect(rec.Body.String()).To(MatchJSON(`{"errors": [
+			{"path": ["path", "id"], "message": "value is not a number"},
+			{"path": ["query", "page"], "message": "value must not be greater than 1"},
+			{"path": ["body", "name"], "message": "value length must not be greater than 2"}
+		]}`))
+	})
+
+	It("should answer 400 to a malformed JSON body", func() {
+		// arrange
+		r := httptest.NewRequest(http.MethodPost, "/items/7?page=1", strings.NewReader(`{"name":`))
+
+		// act
+		rec, got := serve(validatorhttp.Middleware(request), r)
+
+		// assert
+		Expect(got).To(BeNil())
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should answer 413 to a body over MaxBodyBytes", func() {
+		// arrange
+		limited := request
+		limited.MaxBodyBytes = 8
+		r := httptest.NewRequest(http.MethodPost, "/items/7?page=1", strings.NewReader(`{"name":"Johnathan"}`))
+
+		// act
+		rec, got := serve(validatorhttp.Middleware(limited), r)
+
+		// assert
+		Expect(got).To(BeNil())
+		Expect(rec.Code).To(Equal(http.StatusRequestEntityTooLarge))
+	})
+
+	It("should hand the error to a custom ErrorHandler", func() {
+		// arrange
+		var handled error
+		custom := request
+		custom.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+			handled = err
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		r := httptest.NewRequest(http.MethodPost, "/items/7?page=0", strings.NewReader(`{"name":"John"}`))
+
+		// act
+		rec, _ := serve(validatorhttp.Middleware(custom), r)
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusUnprocessableEntity))
+		var errs validator.Errors
+		Expect(errors.As(handled, &errs)).To(BeTrue())
+		Expect(errs[0].Field()).To(Equal("query.page"))
+	})
+
+	It("should answer 500 when a lookup fails", func() {
+		// arrange
+		failing := request
+		failing.Body = validator.Schema{Fields: []validator.Field{
+			validator.StringField("name", validator.StringValidators{validator.StringExistsValidator{
+				Lookup: validator.LookupFunc(func(ctx context.Context, key string) (bool, error) {
+					return false, errors.New("database is down")
+				}),
+			}}),
+		}}
+		r := httptest.NewRequest(http.MethodPost, "/items/7?page=1", strings.NewReader(`{"name":"John"}`))
+
+		// act
+		rec, got := serve(validatorhttp.Middleware(failing), r)
+
+		// assert
+		Expect(got).To(BeNil())
+		Expect(rec.Code).To(Equal(http.StatusInternalServerError))
+	})
+
+	It("should store Values when there is no Bind", func() {
+		// arrange
+		mw := validatorhttp.Middleware(validatorhttp.Request[validatorhttp.Values]{
+			Query: validator.Schema{Fields: []validator.Field{
+				validator.IntField("page", validator.IntValidators{}),
+			}},
+		})
+		var got validatorhttp.Values
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, _ = validatorhttp.FromContext[validatorhttp.Values](r.Context())
+		})
+
+		// act
+		mw(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/?page=3", nil))
+
+		// assert
+		Expect(got.Query).To(Equal(map[string]any{"page": 3}))
+	})
+
+	It("should panic without Bind when T is not Values", func() {
+		// act & assert
+		Expect(func() {
+			validatorhttp.Middleware(validatorhttp.Request[listParams]{})
+		}).To(PanicWith("validatorhttp: Request.Bind is required unless T is Values"))
+	})
+})
+
+var _ = Describe("FromContext", func() {
+	It("should report a missing value", func() {
+		// act
+		_, ok := validatorhttp.FromContext[listParams](context.Background())
+
+		// assert
+		Expect(ok).To(BeFalse())
+	})
+})
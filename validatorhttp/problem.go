@@ -0,0 +1,176 @@
+package validatorhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gungun974/validator"
+)
+
+// ProblemContentType is the media type of an RFC 9457 problem document.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem document whose Errors extension member
+// lists the invalid fields of the request body and whose Parameters extension
+// member lists the invalid path and query parameters.
+type Problem struct {
+	Type       string             `json:"type,omitempty"`
+	Title      string             `json:"title,omitempty"`
+	Status     int                `json:"status,omitempty"`
+	Detail     string             `json:"detail,omitempty"`
+	Instance   string             `json:"instance,omitempty"`
+	Errors     []ProblemError     `json:"errors,omitempty"`
+	Parameters []ProblemParameter `json:"parameters,omitempty"`
+}
+
+// ProblemError is an invalid field of a Problem, located by a JSON pointer.
+type ProblemError struct {
+	Pointer string `json:"pointer"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ProblemParameter is an invalid path or query parameter of a Problem, as
+// told by In.
+type ProblemParameter struct {
+	In      string `json:"in"`
+	Name    string `json:"name"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ProblemRenderer turns validation errors into problem documents.
+type ProblemRenderer struct {
+	// Type is the URI of validation problems, "about:blank" by default.
+	Type string
+	// Title defaults to "Your request is not valid.".
+	Title string
+	// Status of validation problems, 422 by default. A malformed body is
+	// always answered with the status of its BodyError.
+	Status int
+	// BodyType is the URI of malformed body problems, "about:blank" by default.
+	BodyType string
+	// Code defaults to validator.ErrorCode.
+	Code func(err error) string
+}
+
+// Problem returns the problem document of err: a RequestError, a
+// validator.Errors, a *validator.FieldError, a *validator.CodeError such as the
+// missing key of a single ValidateMap call, which has an empty pointer, or a
+// BodyError. Any other error, such as a validator.LookupError or the error of
+// Request.Bind, gets a bare 500 problem, as its message is not meant for
+// clients.
+func (p ProblemRenderer) Problem(err error) Problem {
+	var bodyErr *BodyError
+	if errors.As(err, &bodyErr) {
+		return Problem{
+			Type:   defaultString(p.BodyType, "about:blank"),
+			Title:  http.StatusText(bodyErr.Status),
+			Status: bodyErr.Status,
+			Detail: bodyErr.Error(),
+		}
+	}
+
+	var reqErr *RequestError
+	var errs validator.Errors
+	var fieldErr *validator.FieldError
+	var codeErr *validator.CodeError
+	switch {
+	case validator.IsLookupError(err):
+		return internalProblem()
+	case errors.As(err, &reqErr), errors.As(err, &errs):
+	case errors.As(err, &fieldErr):
+		errs = validator.Errors{fieldErr}
+	case errors.As(err, &codeErr):
+		errs = validator.Errors{{Err: err}}
+	default:
+		return internalProblem()
+	}
+
+	code := p.Code
+	if code == nil {
+		code = validator.ErrorCode
+	}
+
+	problem := Problem{
+		Type:   defaultString(p.Type, "about:blank"),
+		Title:  defaultString(p.Title, "Your request is not valid."),
+		Status: p.Status,
+	}
+	if problem.Status == 0 {
+		problem.Status = http.StatusUnprocessableEntity
+	}
+
+	if reqErr != nil {
+		for _, part := range []struct {
+			in   string
+			errs validator.Errors
+		}{{"path", reqErr.Path}, {"query", reqErr.Query}} {
+			for _, fieldErr := range part.errs {
+				problem.Parameters = append(problem.Parameters, ProblemParameter{
+					In:      part.in,
+					Name:    fieldErr.Field(),
+					Code:    code(fieldErr.Err),
+					Message: fieldErr.Err.Error(),
+				})
+			}
+		}
+		errs = reqErr.Body
+	}
+	for _, fieldErr := range errs {
+		problem.Errors = append(problem.Errors, ProblemError{
+			Pointer: JSONPointer(fieldErr.Path),
+			Code:    code(fieldErr.Err),
+			Message: fieldErr.Err.Error(),
+		})
+	}
+	return problem
+}
+
+// Write writes the problem document of err with the request path as its
+// instance. Its method value fits Request.ErrorHandler.
+func (p ProblemRenderer) Write(w http.ResponseWriter, r *http.Request, err error) {
+	problem := p.Problem(err)
+	if r != nil && problem.Status != http.StatusInternalServerError {
+		problem.Instance = r.URL.Path
+	}
+	WriteProblem(w, problem)
+}
+
+// WriteProblem writes problem as application/problem+json with its Status,
+// 500 when it is zero.
+func WriteProblem(w http.ResponseWriter, problem Problem) {
+	if problem.Status == 0 {
+		problem.Status = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+func internalProblem() Problem {
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusInternalServerError),
+		Status: http.StatusInternalServerError,
+	}
+}
+
+// JSONPointer returns the RFC 6901 pointer of path.
+func JSONPointer(path []string) string {
+	var b strings.Builder
+	for _, key := range path {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(key))
+	}
+	return b.String()
+}
+
+func defaultString(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,198 @@
+package validatorhttp_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gungun974/validator"
+	"github.com/gungun974/validator/validatorhttp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProblemRenderer", func() {
+	schema := validator.Schema{
+		Fields: []validator.Field{
+			validator.StringField("email", validator.StringValidators{validator.StringEmailValidator{}}),
+			validator.IntField("age", validator.IntValidators{validator.IntMinValidator{Min: 18}}),
+			validator.StringField("name", validator.StringValidators{}),
+		},
+		UnknownKeys: validator.UnknownKeysStrict,
+	}
+
+	It("should list every invalid field of a schema", func() {
+		// arrange
+		_, err := schema.Validate(map[string]any{"email": "john", "age": "x", "a/b": 1})
+
+		// act
+		problem := validatorhttp.ProblemRenderer{
+			Type:  "https://example.com/problems/validation",
+			Title: "Invalid input",
+		}.Problem(err)
+
+		// assert
+		Expect(problem).To(Equal(validatorhttp.Problem{
+			Type:   "https://example.com/problems/validation",
+			Title:  "Invalid input",
+			Status: http.StatusUnprocessableEntity,
+			Errors: []validatorhttp.ProblemError{
+				{Pointer: "/email", Code: "invalid", Message: "value is not an email"},
+				{Pointer: "/age", Code: "type", Message: "value is not a number"},
+				{Pointer: "/name", Code: "required", Message: "missing key \"name\""},
+				{Pointer: "/a~1b", Code: "unknown_key", Message: "unknown key"},
+			},
+		}))
+	})
+
+	It("should render the error of a single ValidateMap call at the root", func() {
+		// arrange
+		_, err := validator.ValidateMapInt("age", map[string]any{}, validator.IntValidators{})
+
+		// act
+		problem := validatorhttp.ProblemRenderer{Status: http.StatusBadRequest}.Problem(err)
+
+		// assert
+		Expect(problem.Type).To(Equal("about:blank"))
+		Expect(problem.Status).To(Equal(http.StatusBadRequest))
+		Expect(problem.Errors).To(Equal([]validatorhttp.ProblemError{
+			{Pointer: "", Code: "required", Message: "missing key \"age\""},
+		}))
+	})
+
+	It("should write a problem+json response", func() {
+		// arrange
+		_, err := schema.Validate(map[string]any{"email": "john@example.com", "age": 20})
+		rec := httptest.NewRecorder()
+
+		// act
+		validatorhttp.ProblemRenderer{}.Write(rec, httptest.NewRequest(http.MethodPost, "/users", nil), err)
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusUnprocessableEntity))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+		Expect(rec.Body.String()).To(MatchJSON(`{
+			"type": "about:blank",
+			"title": "Your request is not valid.",
+			"status": 422,
+			"instance": "/users",
+			"errors": [{"pointer": "/name", "code": "required", "message": "missing key \"name\""}]
+		}`))
+	})
+
+	It("should serve as the ErrorHandler of the middleware", func() {
+		// arrange
+		mw := validatorhttp.Middleware(validatorhttp.Request[validatorhttp.Values]{
+			Query: validator.Schema{Fields: []validator.Field{
+				validator.IntField("page", validator.IntValidators{}),
+			}},
+			Body: validator.Schema{Fields: []validator.Field{
+				validator.ObjectField("owner", validator.Schema{Fields: []validator.Field{
+					validator.UUIDField("id", validator.UUIDValidators{validator.UUIDTimeMinValidator{}}),
+				}}),
+			}},
+			ErrorHandler: validatorhttp.ProblemRenderer{Status: http.StatusBadRequest}.Write,
+		})
+		rec := httptest.NewRecorder()
+		body := strings.NewReader(`{"owner": {"id": "c6bd5ed2-8a3f-4b35-9d8c-b2b1a1f3f1a2"}}`)
+
+		// act
+		mw(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/items?page=x", body))
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Body.String()).To(MatchJSON(`{
+			"type": "about:blank",
+			"title": "Your request is not valid.",
+			"status": 400,
+			"instance": "/items",
+			"errors": [
+				{"pointer": "/owner/id", "code": "invalid", "message": "value is not a time-based UUID"}
+			],
+			"parameters": [
+				{"in": "query", "name": "page", "code": "type", "message": "value is not a number"}
+			]
+		}`))
+	})
+
+	It("should answer a malformed body with its status", func() {
+		// arrange
+		mw := validatorhttp.Middleware(validatorhttp.Request[validatorhttp.Values]{
+			Body: validator.Schema{Fields: []validator.Field{
+				validator.StringField("name", validator.StringValidators{}),
+			}},
+			ErrorHandler: validatorhttp.ProblemRenderer{}.Write,
+		})
+		rec := httptest.NewRecorder()
+
+		// act
+		mw(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")))
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Body.String()).To(ContainSubstring(`"title":"Bad Request"`))
+	})
+
+	It("should answer 500 to a Bind error without its message", func() {
+		// arrange
+		mw := validatorhttp.Middleware(validatorhttp.Request[string]{
+			Query: validator.Schema{Fields: []validator.Field{
+				validator.IntField("page", validator.IntValidators{}),
+			}},
+			Bind: func(v validatorhttp.Values) (string, error) {
+				return "", errors.New("db connection refused at 10.0.0.3")
+			},
+			ErrorHandler: validatorhttp.ProblemRenderer{}.Write,
+		})
+		rec := httptest.NewRecorder()
+
+		// act
+		mw(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items?page=1", nil))
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusInternalServerError))
+		Expect(rec.Body.String()).To(MatchJSON(`{"type": "about:blank", "title": "Internal Server Error", "status": 500}`))
+	})
+
+	It("should render a single field error", func() {
+		// arrange
+		err := &validator.FieldError{Path: []string{"age"}, Err: errors.New("value must be even")}
+
+		// act
+		problem := validatorhttp.ProblemRenderer{}.Problem(err)
+
+		// assert
+		Expect(problem.Status).To(Equal(http.StatusUnprocessableEntity))
+		Expect(problem.Errors).To(Equal([]validatorhttp.ProblemError{
+			{Pointer: "/age", Code: "invalid", Message: "value must be even"},
+		}))
+	})
+
+	It("should answer 500 to a lookup error", func() {
+		// arrange
+		rec := httptest.NewRecorder()
+
+		// act
+		validatorhttp.ProblemRenderer{}.Write(rec, nil, &validator.LookupError{Err: errors.New("down")})
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusInternalServerError))
+		Expect(rec.Body.String()).NotTo(ContainSubstring("down"))
+	})
+})
+
+var _ = Describe("WriteProblem", func() {
+	It("should answer 500 to a problem without a status", func() {
+		// arrange
+		rec := httptest.NewRecorder()
+
+		// act
+		validatorhttp.WriteProblem(rec, validatorhttp.Problem{Title: "x"})
+
+		// assert
+		Expect(rec.Code).To(Equal(http.StatusInternalServerError))
+		Expect(rec.Body.String()).To(MatchJSON(`{"title": "x", "status": 500}`))
+	})
+})
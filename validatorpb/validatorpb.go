@@ -0,0 +1,164 @@
+// Package validatorpb converts Protocol Buffers payloads, such as a
+// google.protobuf.Struct received over gRPC, into the map[string]any and Go
+// values read by the validator package, so that the same schemas validate
+// gRPC and JSON payloads.
+package validatorpb
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/gungun974/validator"
+)
+
+// FromStruct converts s into a map, as encoding/json would decode the JSON
+// form of s. A nil s gives a nil map.
+func FromStruct(s *structpb.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+	value := make(map[string]any, len(s.Fields))
+	for key, field := range s.Fields {
+		value[key] = FromValue(field)
+	}
+	return value
+}
+
+// FromValue converts v into nil, a float64, a string, a bool, a
+// map[string]any or a []any.
+func FromValue(v *structpb.Value) any {
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_NumberValue:
+		return kind.NumberValue
+	case *structpb.Value_StringValue:
+		return kind.StringValue
+	case *structpb.Value_BoolValue:
+		return kind.BoolValue
+	case *structpb.Value_StructValue:
+		return FromStruct(kind.StructValue)
+	case *structpb.Value_ListValue:
+		return FromList(kind.ListValue)
+	}
+	return nil
+}
+
+func FromList(l *structpb.ListValue) []any {
+	if l == nil {
+		return nil
+	}
+	values := make([]any, 0, len(l.Values))
+	for _, item := range l.Values {
+		values = append(values, FromValue(item))
+	}
+	return values
+}
+
+// Unwrap converts the well-known types into the Go values read by the
+// validator package: wrappers into their scalar, with integers as int,
+// Timestamp into time.Time, Duration into time.Duration, and Struct, Value
+// and ListValue as FromStruct does. Nil messages give nil, read as missing,
+// and any other value is returned as is.
+func Unwrap(value any) any {
+	switch value := value.(type) {
+	case *structpb.Struct:
+		if value == nil {
+			return nil
+		}
+		return FromStruct(value)
+	case *structpb.Value:
+		return FromValue(value)
+	case *structpb.ListValue:
+		if value == nil {
+			return nil
+		}
+		return FromList(value)
+	case *timestamppb.Timestamp:
+		if value == nil {
+			return nil
+		}
+		return value.AsTime()
+	case *durationpb.Duration:
+		if value == nil {
+			return nil
+		}
+		return value.AsDuration()
+	case *wrapperspb.StringValue:
+		if value == nil {
+			return nil
+		}
+		return value.Value
+	case *wrapperspb.BoolValue:
+		if value == nil {
+			return nil
+		}
+		return value.Value
+	case *wrapperspb.DoubleValue:
+		if value == nil {
+			return nil
+		}
+		return value.Value
+	case *wrapperspb.FloatValue:
+		if value == nil {
+			return nil
+		}
+		return float64(value.Value)
+	case *wrapperspb.Int32Value:
+		if value == nil {
+			return nil
+		}
+		return int(value.Value)
+	case *wrapperspb.Int64Value:
+		if value == nil {
+			return nil
+		}
+		return int(value.Value)
+	case *wrapperspb.UInt32Value:
+		if value == nil {
+			return nil
+		}
+		return int(value.Value)
+	case *wrapperspb.UInt64Value:
+		if value == nil {
+			return nil
+		}
+		return int(value.Value)
+	case *wrapperspb.BytesValue:
+		if value == nil {
+			return nil
+		}
+		return string(value.Value)
+	case int32:
+		return int(value)
+	case int64:
+		return int(value)
+	case uint32:
+		return int(value)
+	case float32:
+		return float64(value)
+	}
+	return value
+}
+
+// UnwrapMap unwraps every value of a map built from the fields of a message.
+func UnwrapMap(value map[string]any) map[string]any {
+	unwrapped := make(map[string]any, len(value))
+	for key, item := range value {
+		unwrapped[key] = Unwrap(item)
+	}
+	return unwrapped
+}
+
+// ValidateStruct validates s with schema.
+func ValidateStruct(
+	schema validator.Schema,
+	s *structpb.Struct,
+	opts ...validator.Option,
+) (map[string]any, error) {
+	value := FromStruct(s)
+	if value == nil {
+		value = map[string]any{}
+	}
+	return schema.Validate(value, opts...)
+}
@@ -0,0 +1,139 @@
+package validatorpb_test
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/gungun974/validator"
+	"github.com/gungun974/validator/validatorpb"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidatorpb(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validatorpb")
+}
+
+var _ = Describe("FromStruct", func() {
+	It("should convert a Struct like a decoded JSON object", func() {
+		// arrange
+		s, err := structpb.NewStruct(map[string]any{
+			"name":    "John",
+			"age":     42,
+			"admin":   false,
+			"address": map[string]any{"city": "Paris"},
+			"tags":    []any{"a", "b"},
+			"deleted": nil,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// act
+		result := validatorpb.FromStruct(s)
+
+		// assert
+		Expect(result).To(Equal(map[string]any{
+			"name":    "John",
+			"age":     42.0,
+			"admin":   false,
+			"address": map[string]any{"city": "Paris"},
+			"tags":    []any{"a", "b"},
+			"deleted": nil,
+		}))
+	})
+})
+
+var _ = Describe("ValidateStruct", func() {
+	schema := validator.Schema{
+		Fields: []validator.Field{
+			validator.StringField("email", validator.StringValidators{validator.StringEmailValidator{}}),
+			validator.IntField("age", validator.IntValidators{}),
+			validator.StringField("nickname", validator.StringValidators{}).Optional(),
+		},
+	}
+
+	It("should validate a Struct with a schema", func() {
+		// arrange
+		s, _ := structpb.NewStruct(map[string]any{"email": "john@doe.com", "age": 42, "nickname": nil})
+
+		// act
+		result, err := validatorpb.ValidateStruct(schema, s)
+
+		// assert
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).To(Equal(map[string]any{"email": "john@doe.com", "age": 42}))
+	})
+
+	It("should report the invalid fields", func() {
+		// arrange
+		s, _ := structpb.NewStruct(map[string]any{"email": "garbage", "age": 4.5})
+
+		// act
+		_, err := validatorpb.ValidateStruct(schema, s)
+
+		// assert
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(Equal("email: value is not an email, age: value is not an int"))
+	})
+
+	It("should report missing fields of a nil Struct", func() {
+		// act
+		_, err := validatorpb.ValidateStruct(schema, nil)
+
+		// assert
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(Equal("email: missing key \"email\", age: missing key \"age\""))
+	})
+})
+
+var _ = Describe("Unwrap", func() {
+	startsAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	DescribeTable("should convert the well-known types",
+		func(value any, expected any) {
+			// act
+			result := validatorpb.Unwrap(value)
+
+			// assert
+			if expected == nil {
+				Expect(result).To(BeNil())
+			} else {
+				Expect(result).To(Equal(expected))
+			}
+		},
+		Entry("a StringValue", wrapperspb.String("John"), "John"),
+		Entry("an Int64Value", wrapperspb.Int64(42), 42),
+		Entry("a UInt32Value", wrapperspb.UInt32(7), 7),
+		Entry("a FloatValue", wrapperspb.Float(1.5), 1.5),
+		Entry("a BoolValue", wrapperspb.Bool(true), true),
+		Entry("a Timestamp", timestamppb.New(startsAt), startsAt),
+		Entry("a Duration", durationpb.New(time.Minute), time.Minute),
+		Entry("a nil StringValue", (*wrapperspb.StringValue)(nil), nil),
+		Entry("an int64", int64(3), 3),
+		Entry("a string", "John", "John"),
+	)
+
+	It("should let the map functions read message fields", func() {
+		// arrange
+		value := validatorpb.UnwrapMap(map[string]any{
+			"limit":     wrapperspb.Int32(20),
+			"starts_at": timestamppb.New(startsAt),
+		})
+
+		// act
+		limit, limitErr := validator.ValidateMapInt("limit", value, validator.IntValidators{})
+		result, timeErr := validator.ValidateMapTime("starts_at", value, validator.TimeValidators{})
+
+		// assert
+		Expect(limitErr).ShouldNot(HaveOccurred())
+		Expect(timeErr).ShouldNot(HaveOccurred())
+		Expect(limit).To(Equal(20))
+		Expect(result).To(Equal(startsAt))
+	})
+})
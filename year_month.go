@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// YearMonth is a month of a year such as a card expiry or a birth month.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+func YearMonthOf(t time.Time) YearMonth {
+	return YearMonth{Year: t.Year(), Month: t.Month()}
+}
+
+func ParseYearMonth(value string) (YearMonth, error) {
+	t, err := time.Parse("2006-01", value)
+	if err != nil {
+		return YearMonth{}, err
+	}
+	return YearMonthOf(t), nil
+}
+
+// IsValid reports whether the month is between January and December.
+func (m YearMonth) IsValid() bool {
+	return m.Month >= time.January && m.Month <= time.December
+}
+
+func (m YearMonth) String() string {
+	return fmt.Sprintf("%04d-%02d", m.Year, m.Month)
+}
+
+func (m YearMonth) FirstDay() Date {
+	return Date{Year: m.Year, Month: m.Month, Day: 1}
+}
+
+func (m YearMonth) LastDay() Date {
+	return DateOf(m.FirstDay().In(time.UTC).AddDate(0, 1, -1))
+}
+
+func (m YearMonth) Compare(other YearMonth) int {
+	if m.Year != other.Year {
+		return compareInts(m.Year, other.Year)
+	}
+	return compareInts(int(m.Month), int(other.Month))
+}
+
+func (m YearMonth) Before(other YearMonth) bool {
+	return m.Compare(other) < 0
+}
+
+func (m YearMonth) After(other YearMonth) bool {
+	return m.Compare(other) > 0
+}
+
+func (m YearMonth) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *YearMonth) UnmarshalText(data []byte) error {
+	yearMonth, err := ParseYearMonth(string(data))
+	if err != nil {
+		return err
+	}
+	*m = yearMonth
+	return nil
+}
+
+func (m YearMonth) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m *YearMonth) Scan(src any) error {
+	switch src := src.(type) {
+	case time.Time:
+		*m = YearMonthOf(src)
+		return nil
+	case string:
+		return m.UnmarshalText([]byte(src))
+	case []byte:
+		return m.UnmarshalText(src)
+	}
+	return fmt.Errorf("cannot scan %T into a YearMonth", src)
+}
+
+type YearMonthValidators []yearMonthValidator
+
+type yearMonthValidator interface {
+	Validate(value YearMonth) error
+}
+
+type YearMonthMaxValidator struct {
+	Max YearMonth
+}
+
+func (v YearMonthMaxValidator) Validate(value YearMonth) error {
+	if value.After(v.Max) {
+		return fmt.Errorf("value must not be after %v", v.Max)
+	}
+	return nil
+}
+
+func (v YearMonthMaxValidator) Describe() Description {
+	return Description{
+		Kind:    "year_month.max",
+		Params:  map[string]any{"max": v.Max},
+		Summary: fmt.Sprintf("in or before %v", v.Max),
+	}
+}
+
+type YearMonthMinValidator struct {
+	Min YearMonth
+}
+
+func (v YearMonthMinValidator) Validate(value YearMonth) error {
+	if value.Before(v.Min) {
+		return fmt.Errorf("value must not be before %v", v.Min)
+	}
+	return nil
+}
+
+func (v YearMonthMinValidator) Describe() Description {
+	return Description{
+		Kind:    "year_month.min",
+		Params:  map[string]any{"min": v.Min},
+		Summary: fmt.Sprintf("in or after %v", v.Min),
+	}
+}
+
+func ValidateMapYearMonth(
+	name string,
+	value map[string]any,
+	rules YearMonthValidators,
+	opts ...Option,
+) (YearMonth, error) {
+	rawValue, ok, err := lookupKey(name, value, opts)
+	if err != nil {
+		return YearMonth{}, err
+	}
+	if !ok {
+		return YearMonth{}, missingKeyError(name)
+	}
+	return ValidateYearMonth(rawValue, rules, opts...)
+}
+
+func ValidateMapYearMonthOrNil(
+	name string,
+	value map[string]any,
+	rules YearMonthValidators,
+	opts ...Option,
+) (*YearMonth, error) {
+	return validateMapOrNil(name, value, ValidateYearMonth, rules, opts)
+}
+
+func ValidateMapYearMonthOrDefault(
+	name string,
+	value map[string]any,
+	rules YearMonthValidators,
+	defaultValue YearMonth,
+	opts ...Option,
+) (YearMonth, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateYearMonth, rules, func() YearMonth {
+		return defaultValue
+	}, opts)
+}
+
+func ValidateMapYearMonthOrDefaultFunc(
+	name string,
+	value map[string]any,
+	rules YearMonthValidators,
+	defaultValue func() YearMonth,
+	opts ...Option,
+) (YearMonth, error) {
+	return validateMapOrDefaultFunc(name, value, ValidateYearMonth, rules, defaultValue, opts)
+}
+
+func ValidateYearMonth(value any, rules YearMonthValidators, opts ...Option) (YearMonth, error) {
+	o := newOptions(opts)
+
+	var yearMonthValue YearMonth
+
+	switch value := value.(type) {
+	case YearMonth:
+		if !value.IsValid() {
+			return YearMonth{}, typeError("value is not a year and month")
+		}
+		yearMonthValue = value
+	case time.Time:
+		yearMonthValue = YearMonthOf(value)
+	case string:
+		yearMonth, err := ParseYearMonth(value)
+		if err != nil || !o.stringToTime() {
+			return YearMonth{}, typeError("value is not a year and month")
+		}
+		yearMonthValue = yearMonth
+	default:
+		return YearMonth{}, typeError("value is not a year and month")
+	}
+
+	if err := applyRules(o.context(), rules, yearMonthValue); err != nil {
+		return YearMonth{}, err
+	}
+
+	return yearMonthValue, nil
+}
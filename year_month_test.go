@@ -0,0 +1,101 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gungun974/validator"
+)
+
+func yearMonthValidatorTests() {
+	Describe("ValidateYearMonth", func() {
+		It("should return a YearMonth", func() {
+			// arrange
+			value := "2024-05"
+
+			// act
+			result, err := validator.ValidateYearMonth(value, validator.YearMonthValidators{})
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(validator.YearMonth{Year: 2024, Month: time.May}))
+		})
+
+		It("should not return a YearMonth when input is garbage", func() {
+			// arrange
+			value := "2024-13"
+
+			// act
+			_, err := validator.ValidateYearMonth(value, validator.YearMonthValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a year and month"))
+		})
+
+		It("should not return a YearMonth with an out of range month", func() {
+			// arrange
+			value := validator.YearMonth{Year: 2024, Month: 13}
+
+			// act
+			_, err := validator.ValidateYearMonth(value, validator.YearMonthValidators{})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value is not a year and month"))
+		})
+
+		It("should not return a YearMonth when it is before min", func() {
+			// arrange
+			value := "2024-04"
+
+			// act
+			_, err := validator.ValidateYearMonth(value, validator.YearMonthValidators{
+				validator.YearMonthMinValidator{Min: validator.YearMonth{Year: 2024, Month: time.May}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be before 2024-05"))
+		})
+
+		It("should not return a YearMonth when it is after max", func() {
+			// arrange
+			value := "2025-01"
+
+			// act
+			_, err := validator.ValidateYearMonth(value, validator.YearMonthValidators{
+				validator.YearMonthMaxValidator{Max: validator.YearMonth{Year: 2024, Month: time.December}},
+			})
+
+			// assert
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(Equal("value must not be after 2024-12"))
+		})
+	})
+
+	Describe("YearMonth", func() {
+		It("should return the last day of the month", func() {
+			// act
+			result := validator.YearMonth{Year: 2024, Month: time.February}.LastDay()
+
+			// assert
+			Expect(result).To(Equal(validator.Date{Year: 2024, Month: time.February, Day: 29}))
+		})
+
+		It("should decode JSON from a string", func() {
+			// arrange
+			var value validator.YearMonth
+
+			// act
+			err := json.Unmarshal([]byte(`"2024-05"`), &value)
+
+			// assert
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value).To(Equal(validator.YearMonth{Year: 2024, Month: time.May}))
+		})
+	})
+}